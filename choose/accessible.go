@@ -0,0 +1,100 @@
+package choose
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/gum/internal/accessible"
+)
+
+// ask lists the options numbered under their group headers, and reads the
+// numbers of the picked ones. Nothing typed picks the selected options, or
+// the one under the cursor.
+func (o Options) ask(items []item, cursor int) ([]int, error) {
+	if o.Header != "" {
+		accessible.Println(o.Header)
+	}
+	var rows []int
+	defaults := []int{cursor}
+	for i, item := range items {
+		if item.header {
+			accessible.Println(item.text + ":")
+			continue
+		}
+		rows = append(rows, i)
+		line := accessible.Item(len(rows), item.text)
+		if item.description != "" {
+			line += " - " + item.description
+		}
+		switch {
+		case item.disabled:
+			line += " (disabled)"
+		case item.selected:
+			line += " (selected)"
+		}
+		accessible.Println(line)
+	}
+
+	var prompt string
+	if o.Limit == 1 {
+		prompt = fmt.Sprintf("Choose from 1 to %d [%d]: ", len(rows), slices.Index(rows, cursor)+1)
+	} else {
+		var selected []int
+		for i, item := range items {
+			if item.selected {
+				selected = append(selected, i)
+			}
+		}
+		sort.SliceStable(selected, func(i, j int) bool {
+			return items[selected[i]].order < items[selected[j]].order
+		})
+		defaults = selected
+		var numbers []string
+		for _, row := range selected {
+			numbers = append(numbers, fmt.Sprint(slices.Index(rows, row)+1))
+		}
+		prompt = fmt.Sprintf("Choose up to %d from 1 to %d, separated by spaces", min(o.Limit, len(rows)), len(rows))
+		if len(numbers) > 0 {
+			prompt += " [" + strings.Join(numbers, " ") + "]"
+		}
+		prompt += ": "
+	}
+
+	for {
+		line, err := accessible.ReadLine(prompt)
+		if err != nil {
+			return nil, err
+		}
+		if strings.TrimSpace(line) == "" {
+			return o.order(defaults), nil
+		}
+		indices, err := accessible.ParseNumbers(line, len(rows), o.Limit)
+		if err != nil {
+			accessible.Println(err.Error())
+			continue
+		}
+		picked := make([]int, len(indices))
+		for i, index := range indices {
+			picked[i] = rows[index]
+			if items[picked[i]].disabled {
+				err = fmt.Errorf("%d cannot be chosen", index+1)
+			}
+		}
+		if err != nil {
+			accessible.Println(err.Error())
+			continue
+		}
+		return o.order(picked), nil
+	}
+}
+
+// order sorts the picked items in the order of the options, unless the
+// order they are picked in matters.
+func (o Options) order(picked []int) []int {
+	if !o.Ordered || o.Limit == 1 {
+		slices.Sort(picked)
+	}
+	return picked
+}
@@ -19,6 +19,17 @@ func Header(header string) func(*Options) {
 	return func(o *Options) { o.Header = header }
 }
 
+// HistoryKey saves the choices under the given key and starts on the most
+// frequently and recently picked option.
+func HistoryKey(key string) func(*Options) {
+	return func(o *Options) { o.HistoryKey = key }
+}
+
+// ID is the key of the prompt in the answers file, instead of the header.
+func ID(id string) func(*Options) {
+	return func(o *Options) { o.ID = id }
+}
+
 func Choose(options []string, optionsFn ...func(*Options)) ([]int, []string, error) {
 	option := &Options{}
 	bingoo.KongParse(option, bingoo.KongVars)
@@ -12,11 +12,15 @@ package choose
 
 import (
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/paginator"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/gum/internal/bindings"
+	"github.com/charmbracelet/gum/internal/mouse"
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -62,6 +66,10 @@ func defaultKeymap() keymap {
 			key.WithKeys("enter", "ctrl+q"),
 			key.WithHelp("enter", "submit"),
 		),
+		Filter: key.NewBinding(
+			key.WithKeys("/"),
+			key.WithHelp("/", "filter"),
+		),
 	}
 }
 
@@ -76,7 +84,8 @@ type keymap struct {
 	Toggle,
 	Abort,
 	Quit,
-	Submit key.Binding
+	Submit,
+	Filter key.Binding
 }
 
 // FullHelp implements help.KeyMap.
@@ -86,11 +95,9 @@ func (k keymap) FullHelp() [][]key.Binding { return nil }
 func (k keymap) ShortHelp() []key.Binding {
 	return []key.Binding{
 		k.Toggle,
-		key.NewBinding(
-			key.WithKeys("up", "down", "right", "left"),
-			key.WithHelp("←↓↑→", "navigate"),
-		),
+		bindings.Help("navigate", k.Left, k.Down, k.Up, k.Right),
 		k.Submit,
+		k.Filter,
 		k.ToggleAll,
 	}
 }
@@ -114,110 +121,211 @@ type model struct {
 	help             help.Model
 	keymap           keymap
 
+	// labelWidth aligns the descriptions.
+	labelWidth int
+
+	// rows are the items shown, those matching the filter. The typed
+	// letters jump to the option they start.
+	rows      []int
+	filter    textinput.Model
+	filtering bool
+	typed     string
+	typedAt   time.Time
+
+	// mouse locates the view to map clicks to options, if the mouse is
+	// enabled.
+	mouse *mouse.Tracker
+
 	// styles
 	cursorStyle       lipgloss.Style
 	headerStyle       lipgloss.Style
 	itemStyle         lipgloss.Style
 	selectedItemStyle lipgloss.Style
+	groupStyle        lipgloss.Style
+	descriptionStyle  lipgloss.Style
+	disabledStyle     lipgloss.Style
 }
 
 type item struct {
 	text     string
 	selected bool
 	order    int
+
+	// index is the position of the option, or -1 for group headers.
+	index       int
+	header      bool
+	disabled    bool
+	description string
+}
+
+// choosable reports whether the cursor can be on the item.
+func (i item) choosable() bool {
+	return !i.header && !i.disabled
+}
+
+// nearest returns the first item that can be chosen from the given one in
+// the direction, else in the other direction, or -1 if there is none.
+func nearest(items []item, from, direction int) int {
+	for _, d := range []int{direction, -direction} {
+		for i := from; i >= 0 && i < len(items); i += d {
+			if items[i].choosable() {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// move moves the cursor to the next row that can be chosen in the
+// direction, wrapping around.
+func (m *model) move(direction int) {
+	n := len(m.rows)
+	p := m.position()
+	for i := 1; i <= n; i++ {
+		if row := m.rows[((p+direction*i)%n+n)%n]; m.items[row].choosable() {
+			m.index = row
+			return
+		}
+	}
+}
+
+// jump moves the cursor to the row nearest to the given position.
+func (m *model) jump(to, direction int) {
+	rows := make([]item, len(m.rows))
+	for i, row := range m.rows {
+		rows[i] = m.items[row]
+	}
+	if p := nearest(rows, clamp(to, 0, len(rows)-1), direction); p >= 0 {
+		m.index = m.rows[p]
+	}
 }
 
 func (m model) Init() tea.Cmd { return nil }
 
+// submit picks the selected options, or the one under the cursor.
+func (m model) submit() (tea.Model, tea.Cmd) {
+	if m.limit <= 1 && m.numSelected < 1 {
+		if m.index < 0 {
+			return m, nil
+		}
+		m.items[m.index].selected = true
+	}
+	m.quitting = true
+	m.submitted = true
+	return m, tea.Quit
+}
+
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	m.mouse.Update(msg)
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
 		return m, nil
 
+	case tea.MouseMsg:
+		return m.updateMouse(msg)
+
 	case tea.KeyMsg:
-		start, end := m.paginator.GetSliceBounds(len(m.items))
+		if m.filtering {
+			return m.updateFilter(msg)
+		}
 		km := m.keymap
+		now := time.Now()
+		if msg.Type == tea.KeyRunes && m.continueTypeAhead(string(msg.Runes), now) {
+			// Letters bound to keys still jump while a prefix is typed.
+			break
+		}
+		// A letter bound to a key does its action, and may also start the
+		// word of an option.
+		prefix := letter(msg)
 		switch {
 		case key.Matches(msg, km.Down):
-			m.index++
-			if m.index >= len(m.items) {
-				m.index = 0
-				m.paginator.Page = 0
-			}
-			if m.index >= end {
-				m.paginator.NextPage()
-			}
+			m.move(1)
 		case key.Matches(msg, km.Up):
-			m.index--
-			if m.index < 0 {
-				m.index = len(m.items) - 1
-				m.paginator.Page = m.paginator.TotalPages - 1
-			}
-			if m.index < start {
-				m.paginator.PrevPage()
-			}
+			m.move(-1)
 		case key.Matches(msg, km.Right):
-			m.index = clamp(m.index+m.height, 0, len(m.items)-1)
-			m.paginator.NextPage()
+			m.jump(m.position()+m.height, 1)
 		case key.Matches(msg, km.Left):
-			m.index = clamp(m.index-m.height, 0, len(m.items)-1)
-			m.paginator.PrevPage()
+			m.jump(m.position()-m.height, -1)
 		case key.Matches(msg, km.End):
-			m.index = len(m.items) - 1
-			m.paginator.Page = m.paginator.TotalPages - 1
+			m.jump(len(m.rows)-1, -1)
 		case key.Matches(msg, km.Home):
-			m.index = 0
-			m.paginator.Page = 0
+			m.jump(0, 1)
 		case key.Matches(msg, km.ToggleAll):
 			if m.limit <= 1 {
 				break
 			}
-			if m.numSelected < len(m.items) && m.numSelected < m.limit {
+			if !m.allSelected() && m.numSelected < m.limit {
 				m = m.selectAll()
 			} else {
 				m = m.deselectAll()
 			}
 		case key.Matches(msg, km.Quit):
+			// The filter is cleared first.
+			if m.filter.Value() != "" {
+				m.clearFilter()
+				break
+			}
 			m.quitting = true
 			return m, tea.Quit
 		case key.Matches(msg, km.Abort):
 			m.quitting = true
 			return m, tea.Interrupt
 		case key.Matches(msg, km.Toggle):
-			if m.limit == 1 {
-				break // no op
-			}
-
-			if m.items[m.index].selected {
-				m.items[m.index].selected = false
-				m.numSelected--
-			} else if m.numSelected < m.limit {
-				m.items[m.index].selected = true
-				m.items[m.index].order = m.currentOrder
-				m.numSelected++
-				m.currentOrder++
-			}
+			m.toggle()
 		case key.Matches(msg, km.Submit):
-			m.quitting = true
-			if m.limit <= 1 && m.numSelected < 1 {
-				m.items[m.index].selected = true
-			}
-			m.submitted = true
-			return m, tea.Quit
+			return m.submit()
+		case key.Matches(msg, km.Filter):
+			m.filtering = true
+			return m, m.filter.Focus()
+		case msg.Type == tea.KeyRunes && !msg.Alt && !msg.Paste:
+			m.typeAhead(string(msg.Runes), now)
+			prefix = ""
+		}
+		if prefix != "" {
+			m.typed, m.typedAt = prefix, now
 		}
 	}
 
+	m.paginator.Page = max(0, m.position()) / m.height
 	var cmd tea.Cmd
 	m.paginator, cmd = m.paginator.Update(msg)
 	return m, cmd
 }
 
+// toggle toggles the option under the cursor.
+func (m *model) toggle() {
+	if m.limit == 1 || m.index < 0 {
+		return // no op
+	}
+
+	if m.items[m.index].selected {
+		m.items[m.index].selected = false
+		m.numSelected--
+	} else if m.numSelected < m.limit {
+		m.items[m.index].selected = true
+		m.items[m.index].order = m.currentOrder
+		m.numSelected++
+		m.currentOrder++
+	}
+}
+
+// allSelected reports whether all the options shown are selected.
+func (m model) allSelected() bool {
+	for _, row := range m.rows {
+		if m.items[row].choosable() && !m.items[row].selected {
+			return false
+		}
+	}
+	return true
+}
+
+// selectAll selects the options shown.
 func (m model) selectAll() model {
-	for i := range m.items {
+	for _, i := range m.rows {
 		if m.numSelected >= m.limit {
 			break // do not exceed given limit
 		}
-		if m.items[i].selected {
+		if m.items[i].selected || !m.items[i].choosable() {
 			continue
 		}
 		m.items[i].selected = true
@@ -245,28 +353,44 @@ func (m model) View() string {
 
 	var s strings.Builder
 
-	start, end := m.paginator.GetSliceBounds(len(m.items))
-	for i, item := range m.items[start:end] {
-		if i == m.index%m.height {
+	start, end := m.paginator.GetSliceBounds(len(m.rows))
+	for i, row := range m.rows[start:end] {
+		item := m.items[row]
+		if item.header {
+			s.WriteString(m.groupStyle.Render(item.text))
+			if i != m.height {
+				s.WriteRune('\n')
+			}
+			continue
+		}
+
+		if row == m.index {
 			s.WriteString(m.cursorStyle.Render(m.cursor))
 		} else {
 			s.WriteString(strings.Repeat(" ", lipgloss.Width(m.cursor)))
 		}
 
-		if item.selected {
+		switch {
+		case item.disabled:
+			s.WriteString(m.disabledStyle.Render(m.unselectedPrefix + item.text))
+		case item.selected:
 			s.WriteString(m.selectedItemStyle.Render(m.selectedPrefix + item.text))
-		} else if i == m.index%m.height {
+		case row == m.index:
 			s.WriteString(m.cursorStyle.Render(m.cursorPrefix + item.text))
-		} else {
+		default:
 			s.WriteString(m.itemStyle.Render(m.unselectedPrefix + item.text))
 		}
+		if item.description != "" {
+			pad := m.labelWidth - lipgloss.Width(item.text) + 2
+			s.WriteString(strings.Repeat(" ", pad) + m.descriptionStyle.Render(item.description))
+		}
 		if i != m.height {
 			s.WriteRune('\n')
 		}
 	}
 
 	if m.paginator.TotalPages > 1 {
-		s.WriteString(strings.Repeat("\n", m.height-m.paginator.ItemsOnPage(len(m.items))+1))
+		s.WriteString(strings.Repeat("\n", m.height-m.paginator.ItemsOnPage(len(m.rows))+1))
 		s.WriteString("  " + m.paginator.View())
 	}
 
@@ -275,12 +399,15 @@ func (m model) View() string {
 	if m.header != "" {
 		parts = append(parts, m.headerStyle.Render(m.header))
 	}
+	if m.filtering || m.filter.Value() != "" {
+		parts = append(parts, m.filter.View())
+	}
 	parts = append(parts, s.String())
 	if m.showHelp {
 		parts = append(parts, m.help.View(m.keymap))
 	}
 
-	return lipgloss.JoinVertical(lipgloss.Left, parts...)
+	return m.mouse.Render(lipgloss.JoinVertical(lipgloss.Left, parts...))
 }
 
 func clamp(x, low, high int) int {
@@ -10,7 +10,13 @@ import (
 
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/paginator"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/gum/internal/accessible"
+	"github.com/charmbracelet/gum/internal/answer"
+	"github.com/charmbracelet/gum/internal/bindings"
+	"github.com/charmbracelet/gum/internal/history"
+	"github.com/charmbracelet/gum/internal/mouse"
 	"github.com/charmbracelet/gum/internal/stdin"
 	"github.com/charmbracelet/gum/internal/timeout"
 	"github.com/charmbracelet/lipgloss"
@@ -32,28 +38,21 @@ func (o Options) RunBingoo() ([]int, []string, error) {
 		o.Options = strings.Split(input, o.InputDelimiter)
 	}
 
-	// normalize options into a map
-	options := map[string]string{}
-	// keep the labels in the user-provided order
-	var labels []string
-	for _, opt := range o.Options {
-		if o.LabelDelimiter == "" {
-			options[opt] = opt
-			continue
-		}
-		label, value, ok := strings.Cut(opt, o.LabelDelimiter)
-		if !ok {
-			return nil, nil, fmt.Errorf("invalid option format: %q", opt)
-		}
-		labels = append(labels, label)
-		options[label] = value
+	if o.JSON {
+		// The options are JSON values, not lines.
+		o.Options = []string{strings.Join(o.Options, o.InputDelimiter)}
 	}
-	if o.LabelDelimiter != "" {
-		o.Options = labels
+	entries, err := o.entries()
+	if err != nil {
+		return nil, nil, err
+	}
+	o.Options = make([]string, len(entries))
+	for i, e := range entries {
+		o.Options[i] = e.Label
 	}
 
-	if o.SelectIfOne && len(o.Options) == 1 {
-		return []int{0}, []string{options[o.Options[0]]}, nil
+	if o.SelectIfOne && len(entries) == 1 && !entries[0].Disabled {
+		return []int{0}, []string{entries[0].Value}, nil
 	}
 
 	// We don't need to display prefixes if we are only picking one option.
@@ -68,23 +67,21 @@ func (o Options) RunBingoo() ([]int, []string, error) {
 		o.Limit = len(o.Options) + 1
 	}
 
-	if o.Ordered {
-		slices.SortFunc(o.Options, strings.Compare)
-	}
-
 	isSelectAll := len(o.Selected) == 1 && o.Selected[0] == "*"
 
 	// Keep track of the selected items.
 	currentSelected := 0
 	// Check if selected items should be used.
 	hasSelectedItems := len(o.Selected) > 0
-	startingIndex := 0
+	startingIndex := -1
 	currentOrder := 0
-	items := make([]item, len(o.Options))
-	for i, option := range o.Options {
-		var order int
+	items := rows(entries)
+	for i, item := range items {
+		if !item.choosable() {
+			continue
+		}
 		// Check if the option should be selected.
-		isSelected := hasSelectedItems && currentSelected < o.Limit && (isSelectAll || slices.Contains(o.Selected, option))
+		isSelected := hasSelectedItems && currentSelected < o.Limit && (isSelectAll || slices.Contains(o.Selected, item.text))
 		// If the option is selected then increment the current selected count.
 		if isSelected {
 			if o.Limit == 1 {
@@ -94,11 +91,53 @@ func (o Options) RunBingoo() ([]int, []string, error) {
 				isSelected = false
 			} else {
 				currentSelected++
-				order = currentOrder
+				items[i].order = currentOrder
 				currentOrder++
 			}
 		}
-		items[i] = item{text: option, selected: isSelected, order: order}
+		items[i].selected = isSelected
+	}
+
+	// Start on the most frecent option, unless told otherwise.
+	if o.HistoryKey != "" && o.Limit == 1 && startingIndex < 0 {
+		scores, err := history.Scores(o.HistoryKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		best := 0.0
+		for i, item := range items {
+			if item.choosable() && scores[item.text] > best {
+				best = scores[item.text]
+				startingIndex = i
+			}
+		}
+	}
+	if startingIndex = nearest(items, max(0, startingIndex), 1); startingIndex < 0 {
+		return nil, nil, errors.New("no option can be chosen")
+	}
+
+	prompt := answer.Prompt{Command: "choose", ID: answer.Key(o.ID, o.Header), Options: o.Options}
+	if picked, ok, err := o.answer(prompt, items, startingIndex); ok || err != nil {
+		if err != nil {
+			return nil, nil, err
+		}
+		var selected []int
+		var out []string
+		selected, prompt.Answer, out = pick(entries, items, picked)
+		return selected, out, answer.Record(prompt)
+	}
+
+	if accessible.On() {
+		picked, err := o.ask(items, startingIndex)
+		if err != nil {
+			return nil, nil, err
+		}
+		selected, labels, out := pick(entries, items, picked)
+		if err := history.Record(o.HistoryKey, labels...); err != nil {
+			return nil, nil, err
+		}
+		prompt.Answer = labels
+		return selected, out, answer.Record(prompt)
 	}
 
 	// Use the pagination model to display the current and total number of
@@ -113,6 +152,9 @@ func (o Options) RunBingoo() ([]int, []string, error) {
 	pager.Page = startingIndex / o.Height
 
 	km := defaultKeymap()
+	if err := bindings.Remap("choose", o.Keymap, &km); err != nil {
+		return nil, nil, err
+	}
 	if o.NoLimit || o.Limit > 1 {
 		km.Toggle.SetEnabled(true)
 	}
@@ -136,21 +178,35 @@ func (o Options) RunBingoo() ([]int, []string, error) {
 		headerStyle:       o.HeaderStyle.ToLipgloss(),
 		itemStyle:         o.ItemStyle.ToLipgloss(),
 		selectedItemStyle: o.SelectedItemStyle.ToLipgloss(),
+		groupStyle:        o.GroupStyle.ToLipgloss(),
+		descriptionStyle:  o.DescriptionStyle.ToLipgloss(),
+		disabledStyle:     o.DisabledStyle.ToLipgloss(),
+		labelWidth:        labelWidth(items),
 		numSelected:       currentSelected,
 		showHelp:          o.ShowHelp,
 		help:              help.New(),
 		keymap:            km,
 	}
 
+	m.filter = textinput.New()
+	m.filter.Prompt = "/"
+	m.filter.PromptStyle = m.cursorStyle
+	m.setRows()
+
 	ctx, cancel := timeout.Context(o.Timeout)
 	defer cancel()
 
-	// Disable Keybindings since we will control it ourselves.
-	tm, err := tea.NewProgram(
-		m,
+	options := []tea.ProgramOption{
 		tea.WithOutput(os.Stderr),
 		tea.WithContext(ctx),
-	).Run()
+	}
+	if o.Mouse {
+		m.mouse = mouse.New()
+		options = append(options, tea.WithMouseCellMotion())
+	}
+
+	// Disable Keybindings since we will control it ourselves.
+	tm, err := tea.NewProgram(m, options...).Run()
 	if err != nil {
 		return nil, nil, fmt.Errorf("unable to pick selection: %w", err)
 	}
@@ -165,12 +221,83 @@ func (o Options) RunBingoo() ([]int, []string, error) {
 	}
 
 	var selected []int
-	var out []string
-	for i, item := range m.items {
+	var picked, out []string
+	for _, item := range m.items {
 		if item.selected {
-			selected = append(selected, i)
-			out = append(out, options[item.text])
+			selected = append(selected, item.index)
+			picked = append(picked, item.text)
+			out = append(out, entries[item.index].Value)
+		}
+	}
+	if err := history.Record(o.HistoryKey, picked...); err != nil {
+		return nil, nil, err
+	}
+	prompt.Answer = picked
+	return selected, out, answer.Record(prompt)
+}
+
+// answer picks the options from the recording or the answers file, or when
+// assuming yes the selected options, else the one under the cursor.
+func (o Options) answer(prompt answer.Prompt, items []item, cursor int) ([]int, bool, error) {
+	key := prompt.ID
+	answers, ok, err := answer.Lookup(prompt)
+	if err != nil {
+		return nil, false, err
+	}
+	if !ok {
+		if !answer.Yes() {
+			return nil, false, nil
+		}
+		var picked []int
+		for i, item := range items {
+			if item.selected {
+				picked = append(picked, i)
+			}
+		}
+		if len(picked) == 0 {
+			picked = []int{cursor}
+		}
+		return picked, true, nil
+	}
+
+	switch {
+	case len(answers) == 0:
+		return nil, false, fmt.Errorf("no answer to %q", key)
+	case len(answers) > o.Limit:
+		return nil, false, fmt.Errorf("expected at most %d answers to %q, got %d", o.Limit, key, len(answers))
+	}
+	picked := make([]int, 0, len(answers))
+	for _, a := range answers {
+		i := slices.IndexFunc(items, func(item item) bool { return !item.header && item.text == a })
+		if i < 0 {
+			return nil, false, fmt.Errorf("%q is not an option of %q", a, key)
+		}
+		if items[i].disabled {
+			return nil, false, fmt.Errorf("%q cannot be chosen", a)
+		}
+		picked = append(picked, i)
+	}
+	return o.order(picked), true, nil
+}
+
+// pick returns the indices, labels and values of the options of the picked
+// items.
+func pick(entries []entry, items []item, picked []int) (selected []int, labels, out []string) {
+	for _, row := range picked {
+		selected = append(selected, items[row].index)
+		labels = append(labels, items[row].text)
+		out = append(out, entries[items[row].index].Value)
+	}
+	return selected, labels, out
+}
+
+// labelWidth returns the width of the widest label with a description.
+func labelWidth(items []item) int {
+	width := 0
+	for _, item := range items {
+		if item.description != "" {
+			width = max(width, lipgloss.Width(item.text))
 		}
 	}
-	return selected, out, nil
+	return width
 }
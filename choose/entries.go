@@ -0,0 +1,138 @@
+package choose
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// entry is an option to choose.
+type entry struct {
+	Label       string `json:"label"`
+	Value       string `json:"value"`
+	Description string `json:"description"`
+	Group       string `json:"group"`
+	Disabled    bool   `json:"disabled"`
+}
+
+// UnmarshalJSON reads an entry from a string or an object.
+func (e *entry) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &e.Label); err == nil {
+		e.Value = e.Label
+		return nil
+	}
+	type plain entry
+	if err := json.Unmarshal(data, (*plain)(e)); err != nil {
+		return err
+	}
+	if e.Label == "" {
+		e.Label = e.Value
+	}
+	if e.Label == "" {
+		return fmt.Errorf("option %s has no label", data)
+	}
+	if e.Value == "" {
+		e.Value = e.Label
+	}
+	return nil
+}
+
+// parseJSON reads entries from JSON values, which are either entries or
+// arrays of entries.
+func parseJSON(input string) ([]entry, error) {
+	var entries []entry
+	d := json.NewDecoder(strings.NewReader(input))
+	for {
+		var raw json.RawMessage
+		err := d.Decode(&raw)
+		if errors.Is(err, io.EOF) {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid options: %w", err)
+		}
+		if raw[0] == '[' {
+			var list []entry
+			if err := json.Unmarshal(raw, &list); err != nil {
+				return nil, fmt.Errorf("invalid options: %w", err)
+			}
+			entries = append(entries, list...)
+			continue
+		}
+		var e entry
+		if err := json.Unmarshal(raw, &e); err != nil {
+			return nil, fmt.Errorf("invalid options: %w", err)
+		}
+		entries = append(entries, e)
+	}
+}
+
+// entries parses the options, as JSON or label:value pairs, and gathers them
+// by group. Groups are listed in the order they first appear in, and options
+// are sorted within their group if they are ordered.
+func (o Options) entries() ([]entry, error) {
+	var entries []entry
+	switch {
+	case o.JSON:
+		for _, opt := range o.Options {
+			es, err := parseJSON(opt)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, es...)
+		}
+	case o.LabelDelimiter != "":
+		for _, opt := range o.Options {
+			label, value, ok := strings.Cut(opt, o.LabelDelimiter)
+			if !ok {
+				return nil, fmt.Errorf("invalid option format: %q", opt)
+			}
+			entries = append(entries, entry{Label: label, Value: value})
+		}
+	default:
+		for _, opt := range o.Options {
+			entries = append(entries, entry{Label: opt, Value: opt})
+		}
+	}
+
+	var groups []string
+	byGroup := map[string][]entry{}
+	for _, e := range entries {
+		if _, ok := byGroup[e.Group]; !ok {
+			groups = append(groups, e.Group)
+		}
+		byGroup[e.Group] = append(byGroup[e.Group], e)
+	}
+	entries = entries[:0]
+	for _, group := range groups {
+		if o.Ordered {
+			slices.SortStableFunc(byGroup[group], func(a, b entry) int {
+				return strings.Compare(a.Label, b.Label)
+			})
+		}
+		entries = append(entries, byGroup[group]...)
+	}
+	return entries, nil
+}
+
+// rows lists the items of the entries, with a header before each group.
+func rows(entries []entry) []item {
+	var items []item
+	group := ""
+	for i, e := range entries {
+		if e.Group != group {
+			items = append(items, item{text: e.Group, index: -1, header: true})
+			group = e.Group
+		}
+		items = append(items, item{
+			text:        e.Label,
+			index:       i,
+			description: e.Description,
+			disabled:    e.Disabled,
+		})
+	}
+	return items
+}
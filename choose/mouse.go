@@ -0,0 +1,48 @@
+package choose
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// updateMouse moves the cursor with the wheel, and handles clicks on the
+// options: they are toggled if several can be chosen, and else the cursor is
+// moved to them, the option under the cursor being submitted.
+func (m model) updateMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	if msg.Action != tea.MouseActionPress {
+		return m, nil
+	}
+	switch msg.Button {
+	case tea.MouseButtonWheelDown:
+		m.move(1)
+	case tea.MouseButtonWheelUp:
+		m.move(-1)
+	case tea.MouseButtonLeft:
+		row := m.rowAt(m.mouse.Line(msg))
+		if row < 0 || !m.items[row].choosable() {
+			break
+		}
+		if m.limit <= 1 && row == m.index {
+			return m.submit()
+		}
+		m.index = row
+		m.toggle()
+	}
+	m.paginator.Page = max(0, m.position()) / m.height
+	return m, nil
+}
+
+// rowAt returns the row shown on the given line of the view, or -1.
+func (m model) rowAt(line int) int {
+	if m.header != "" {
+		line -= lipgloss.Height(m.headerStyle.Render(m.header))
+	}
+	if m.filtering || m.filter.Value() != "" {
+		line--
+	}
+	start, end := m.paginator.GetSliceBounds(len(m.rows))
+	if line < 0 || start+line >= end {
+		return -1
+	}
+	return m.rows[start+line]
+}
@@ -0,0 +1,151 @@
+package choose
+
+import (
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// typeAheadTimeout is how long typed letters are kept to jump to an option.
+const typeAheadTimeout = time.Second
+
+// setRows shows the options matching the filter, under their group headers,
+// keeping the cursor on them.
+func (m *model) setRows() {
+	query := strings.ToLower(m.filter.Value())
+	m.rows = nil
+	header := -1
+	for i, item := range m.items {
+		switch {
+		case item.header:
+			header = i
+		case strings.Contains(strings.ToLower(item.text), query):
+			if header >= 0 {
+				m.rows = append(m.rows, header)
+				header = -1
+			}
+			m.rows = append(m.rows, i)
+		}
+	}
+	m.paginator.TotalPages = max(1, (len(m.rows)+m.height-1)/m.height)
+	if m.position() < 0 || m.index < 0 {
+		m.index = -1
+		m.jump(0, 1)
+	}
+}
+
+// position returns the position of the cursor in the rows, or -1.
+func (m model) position() int {
+	return slices.Index(m.rows, m.index)
+}
+
+// typeAhead jumps to the next option starting with the typed letters. The
+// letters are kept for a second, so that typing a word jumps to it.
+func (m *model) typeAhead(s string, now time.Time) {
+	s = strings.ToLower(s)
+	if now.Sub(m.typedAt) > typeAheadTimeout {
+		m.typed = ""
+	}
+	m.typedAt = now
+	m.typed += s
+
+	// A new prefix jumps to the next option, a longer one may still match
+	// the option under the cursor. If it matches none, the letters start a
+	// new prefix, so that typing a letter again cycles through the options
+	// starting with it.
+	offset := 0
+	if m.typed == s {
+		offset = 1
+	}
+	if !m.jumpToPrefix(m.typed, offset) && m.typed != s {
+		m.typed = s
+		m.jumpToPrefix(s, 1)
+	}
+}
+
+// continueTypeAhead jumps to the option starting with the letters typed so
+// far and the given ones, and reports whether there is one.
+func (m *model) continueTypeAhead(s string, now time.Time) bool {
+	if m.typed == "" || now.Sub(m.typedAt) > typeAheadTimeout {
+		return false
+	}
+	prefix := m.typed + strings.ToLower(s)
+	if !m.jumpToPrefix(prefix, 0) {
+		return false
+	}
+	m.typed, m.typedAt = prefix, now
+	return true
+}
+
+// letter returns the letter typed, lowercased, or nothing for other keys.
+func letter(msg tea.KeyMsg) string {
+	if msg.Type != tea.KeyRunes || msg.Alt || msg.Paste || len(msg.Runes) != 1 || !unicode.IsLetter(msg.Runes[0]) {
+		return ""
+	}
+	return string(unicode.ToLower(msg.Runes[0]))
+}
+
+// jumpToPrefix moves the cursor to the first option starting with the
+// prefix, from the given offset after the cursor, and reports whether there
+// is one.
+func (m *model) jumpToPrefix(prefix string, offset int) bool {
+	n := len(m.rows)
+	p := max(0, m.position())
+	for i := offset; i < n+offset; i++ {
+		row := m.rows[(p+i)%n]
+		item := m.items[row]
+		if item.choosable() && strings.HasPrefix(strings.ToLower(item.text), prefix) {
+			m.index = row
+			return true
+		}
+	}
+	return false
+}
+
+// updateFilter handles the keys while the filter is typed. Arrows move the
+// cursor and tab toggles, as remapped, other keys edit the filter.
+func (m model) updateFilter(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	km := m.keymap
+	// Letters and space are typed, even when they are bound.
+	typed := msg.Type == tea.KeyRunes || msg.Type == tea.KeySpace
+	switch {
+	case key.Matches(msg, km.Abort):
+		m.quitting = true
+		return m, tea.Interrupt
+	case key.Matches(msg, km.Quit):
+		m.clearFilter()
+	case key.Matches(msg, km.Submit):
+		// The filter is kept, and choices are submitted as usual.
+		m.filtering = false
+		m.filter.Blur()
+	case !typed && key.Matches(msg, km.Down):
+		m.move(1)
+	case !typed && key.Matches(msg, km.Up):
+		m.move(-1)
+	case !typed && key.Matches(msg, km.Toggle):
+		m.toggle()
+	default:
+		value := m.filter.Value()
+		var cmd tea.Cmd
+		m.filter, cmd = m.filter.Update(msg)
+		if m.filter.Value() != value {
+			m.setRows()
+		}
+		m.paginator.Page = max(0, m.position()) / m.height
+		return m, cmd
+	}
+	m.paginator.Page = max(0, m.position()) / m.height
+	return m, nil
+}
+
+// clearFilter closes the filter and shows all the options again.
+func (m *model) clearFilter() {
+	m.filtering = false
+	m.filter.Blur()
+	m.filter.Reset()
+	m.setRows()
+}
@@ -0,0 +1,47 @@
+package confirm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/gum/internal/accessible"
+)
+
+// ask asks the prompt on a line, answered with yes or no, or the label of a
+// button. Nothing typed chooses the button under the cursor.
+func (o Options) ask(buttons []button, cursor int) (int, error) {
+	prompt := o.Prompt
+	if o.PromptFn != nil {
+		prompt = o.PromptFn()
+	}
+	switch {
+	case o.RequireText != "":
+		prompt = fmt.Sprintf("%s Type %q to confirm: ", prompt, o.RequireText)
+	case len(o.Buttons) == 0 && len(buttons) == 2 && o.Affirmative == "Yes" && o.Negative == "No":
+		choices := []string{"y", "n"}
+		choices[cursor] = strings.ToUpper(choices[cursor])
+		prompt = fmt.Sprintf("%s [%s]: ", prompt, strings.Join(choices, "/"))
+	default:
+		labels := make([]string, len(buttons))
+		for i, b := range buttons {
+			labels[i] = b.label
+		}
+		prompt = fmt.Sprintf("%s (%s) [%s]: ", prompt, strings.Join(labels, "/"), buttons[cursor].label)
+	}
+
+	for {
+		line, err := accessible.ReadLine(prompt)
+		if err != nil {
+			return -1, err
+		}
+		if strings.TrimSpace(line) == "" && o.RequireText == "" {
+			return cursor, nil
+		}
+		i, err := o.choice(buttons, line)
+		if err != nil {
+			accessible.Println(err.Error())
+			continue
+		}
+		return i, nil
+	}
+}
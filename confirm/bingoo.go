@@ -23,10 +23,36 @@ func Negative(negative string) func(*Options) {
 	return func(o *Options) { o.Negative = negative }
 }
 
+// Buttons shows the buttons instead of the affirmative and negative ones.
+// Their labels may end with :exitcode for the command line.
+func Buttons(labels ...string) func(*Options) {
+	return func(o *Options) { o.Buttons = labels }
+}
+
+// DefaultButton selects the button with the label at first.
+func DefaultButton(label string) func(*Options) {
+	return func(o *Options) { o.DefaultButton = label }
+}
+
+// RequireText must be typed before the first button can be chosen.
+func RequireText(text string) func(*Options) {
+	return func(o *Options) { o.RequireText = text }
+}
+
+// Hold is how long the key of the first button must be held to choose it.
+func Hold(d time.Duration) func(*Options) {
+	return func(o *Options) { o.Hold = d }
+}
+
 func Prompt(prompt string) func(*Options) {
 	return func(o *Options) { o.Prompt = prompt }
 }
 
+// ID is the key of the prompt in the answers file, instead of the prompt.
+func ID(id string) func(*Options) {
+	return func(o *Options) { o.ID = id }
+}
+
 func PromptFn(promptFn func() string) func(*Options) {
 	return func(o *Options) { o.PromptFn = promptFn }
 }
@@ -42,14 +68,39 @@ func Confirm(optionsFn ...func(*Options)) (bool, error) {
 	return option.RunBingoo()
 }
 
+// Choose prompts to choose one of the buttons, and returns its index, or -1
+// if the prompt was quit.
+func Choose(optionsFn ...func(*Options)) (int, error) {
+	option := &Options{}
+	bingoo.KongParse(option, bingoo.KongVars)
+
+	for _, fn := range optionsFn {
+		fn(option)
+	}
+
+	return option.RunChoice()
+}
+
 func (o Options) Run() error {
-	ok, err := o.RunBingoo()
+	chosen, err := o.RunChoice()
 	if err != nil {
 		return err
 	}
-	if !ok {
+	buttons, err := o.buttons()
+	if err != nil {
+		return err
+	}
+	if chosen < 0 {
+		// Custom buttons exit with their position, which quitting must not
+		// be mistaken for.
+		if len(o.Buttons) > 0 {
+			return exit.ErrExit(exit.StatusAborted)
+		}
 		return exit.ErrExit(1)
 	}
+	if code := buttons[chosen].code; code != 0 {
+		return exit.ErrExit(code)
+	}
 
 	return nil
 }
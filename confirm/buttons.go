@@ -0,0 +1,83 @@
+package confirm
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/key"
+)
+
+// button is an answer of the prompt.
+type button struct {
+	label string
+	// code is the exit code of the button.
+	code int
+	key  key.Binding
+}
+
+// defaultButtons returns the affirmative and negative buttons.
+func defaultButtons(affirmative, negative string) []button {
+	buttons := []button{{
+		label: affirmative,
+		code:  0,
+		key:   key.NewBinding(key.WithKeys("y", "Y"), key.WithHelp("y", affirmative)),
+	}}
+	// If the negative button is intentionally empty, do not show it.
+	if negative != "" {
+		buttons = append(buttons, button{
+			label: negative,
+			code:  1,
+			key:   key.NewBinding(key.WithKeys("n", "N", "q"), key.WithHelp("n", negative)),
+		})
+	}
+	return buttons
+}
+
+// parseButtons parses label[:exitcode] buttons, whose exit codes default to
+// their position. Each button is picked with the first letter of its label
+// not used by the keymap or a previous button.
+func parseButtons(specs []string, keys keymap) ([]button, error) {
+	buttons := make([]button, 0, len(specs))
+	used := map[rune]bool{}
+	for _, b := range []key.Binding{keys.Abort, keys.Quit, keys.Prev, keys.Next, keys.Submit} {
+		for _, k := range b.Keys() {
+			if r := []rune(k); len(r) == 1 {
+				used[unicode.ToLower(r[0])] = true
+			}
+		}
+	}
+	for i, spec := range specs {
+		b := button{label: spec, code: i}
+		if j := strings.LastIndex(spec, ":"); j >= 0 {
+			if code, err := strconv.Atoi(spec[j+1:]); err == nil {
+				b.label, b.code = spec[:j], code
+			}
+		}
+		if b.label == "" {
+			return nil, fmt.Errorf("button %q has no label", spec)
+		}
+		for _, r := range strings.ToLower(b.label) {
+			if !unicode.IsLetter(r) && !unicode.IsDigit(r) || used[r] {
+				continue
+			}
+			used[r] = true
+			lower, upper := string(r), string(unicode.ToUpper(r))
+			b.key = key.NewBinding(key.WithKeys(lower, upper), key.WithHelp(lower, b.label))
+			break
+		}
+		buttons = append(buttons, b)
+	}
+	return buttons, nil
+}
+
+// find returns the index of the button with the label, ignoring case, or -1.
+func find(buttons []button, label string) int {
+	for i, b := range buttons {
+		if strings.EqualFold(b.label, label) {
+			return i
+		}
+	}
+	return -1
+}
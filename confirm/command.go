@@ -3,9 +3,16 @@ package confirm
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/gum/internal/accessible"
+	"github.com/charmbracelet/gum/internal/answer"
+	"github.com/charmbracelet/gum/internal/bindings"
+	"github.com/charmbracelet/gum/internal/mouse"
 	"github.com/charmbracelet/gum/internal/stdin"
 	"github.com/charmbracelet/gum/internal/timeout"
 )
@@ -13,51 +20,202 @@ import (
 // RunBingoo provides a shell script interface for prompting a user to confirm an
 // action with an affirmative or negative answer.
 func (o Options) RunBingoo() (bool, error) {
+	chosen, err := o.RunChoice()
+	return chosen == 0, err
+}
+
+// RunChoice prompts the user to choose one of the buttons, and returns its
+// index, or -1 if the prompt was quit.
+func (o Options) RunChoice() (int, error) {
+	buttons, err := o.buttons()
+	if err != nil {
+		return -1, err
+	}
+	prompt := answer.Prompt{Command: "confirm", ID: answer.Key(o.ID, o.Prompt)}
+	for _, b := range buttons {
+		prompt.Options = append(prompt.Options, b.label)
+	}
+	chosen, err := o.run(buttons, prompt)
+	if err != nil {
+		return -1, err
+	}
+	switch {
+	case chosen == 0 && o.RequireText != "":
+		prompt.Answer = []string{o.RequireText}
+	case chosen >= 0:
+		prompt.Answer = []string{buttons[chosen].label}
+	}
+	return chosen, answer.Record(prompt)
+}
+
+// run answers the prompt, or else prompts the user.
+func (o Options) run(buttons []button, prompt answer.Prompt) (int, error) {
+	cursor := 0
+	if !o.Default && len(o.Buttons) == 0 && len(buttons) > 1 {
+		cursor = 1
+	}
+	if o.DefaultButton != "" {
+		if cursor = find(buttons, o.DefaultButton); cursor < 0 {
+			return -1, fmt.Errorf("no button is labeled %q", o.DefaultButton)
+		}
+	}
+
+	if i, ok, err := o.answer(buttons, prompt); ok || err != nil {
+		return i, err
+	}
 	line, err := stdin.Read(stdin.SingleLine(true))
 	if err == nil {
-		switch line {
-		case "yes", "y":
-			return true, nil
-		default:
-			return false, nil
-		}
+		return o.choice(buttons, line)
+	}
+	if accessible.On() {
+		return o.ask(buttons, cursor)
 	}
 
 	ctx, cancel := timeout.Context(o.Timeout)
 	defer cancel()
 
+	keys, err := o.keymap()
+	if err != nil {
+		return -1, err
+	}
+	if o.Hold > 0 {
+		keys.Submit.SetHelp("hold "+keys.Submit.Help().Key, "submit")
+		buttons[0].key.SetHelp("hold "+buttons[0].key.Help().Key, buttons[0].label)
+	}
+	text := textinput.New()
+	if o.RequireText != "" {
+		// Letters are typed, buttons are toggled with tab only.
+		keys.Prev = key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("tab", "toggle"))
+		keys.Next = key.NewBinding(key.WithKeys("tab"))
+		for i := range buttons {
+			buttons[i].key.SetEnabled(false)
+		}
+		text.Placeholder = o.RequireText
+		text.Focus()
+	}
+	for _, b := range buttons {
+		keys.Buttons = append(keys.Buttons, b.key)
+	}
 	m := model{
-		affirmative:      o.Affirmative,
-		negative:         o.Negative,
-		showOutput:       o.ShowOutput,
-		confirmation:     o.Default,
-		defaultSelection: o.Default,
-		keys:             defaultKeymap(o.Affirmative, o.Negative),
-		help:             help.New(),
-		showHelp:         o.ShowHelp,
-		prompt:           o.Prompt,
-		promptFn:         o.PromptFn,
-		selectedStyle:    o.SelectedStyle.ToLipgloss(),
-		unselectedStyle:  o.UnselectedStyle.ToLipgloss(),
-		promptStyle:      o.PromptStyle.ToLipgloss(),
-	}
-	tm, err := tea.NewProgram(
-		m,
+		requireText:     o.RequireText,
+		text:            text,
+		hold:            o.Hold,
+		buttons:         buttons,
+		showOutput:      o.ShowOutput,
+		cursor:          cursor,
+		chosen:          -1,
+		keys:            keys,
+		help:            help.New(),
+		showHelp:        o.ShowHelp,
+		prompt:          o.Prompt,
+		promptFn:        o.PromptFn,
+		selectedStyle:   o.SelectedStyle.ToLipgloss(),
+		unselectedStyle: o.UnselectedStyle.ToLipgloss(),
+		promptStyle:     o.PromptStyle.ToLipgloss(),
+	}
+	options := []tea.ProgramOption{
 		tea.WithOutput(os.Stderr),
 		tea.WithContext(ctx),
-	).Run()
+	}
+	if o.Mouse {
+		m.mouse = mouse.New()
+		options = append(options, tea.WithMouseCellMotion())
+	}
+	tm, err := tea.NewProgram(m, options...).Run()
 	if err != nil {
-		return false, fmt.Errorf("unable to confirm: %w", err)
+		return -1, fmt.Errorf("unable to confirm: %w", err)
 	}
 	m = tm.(model)
 
 	if o.ShowOutput {
-		confirmationText := m.negative
-		if m.confirmation {
-			confirmationText = m.affirmative
+		// Quitting answers the negative button, or the last one.
+		shown := m.chosen
+		if shown < 0 {
+			shown = len(buttons) - 1
 		}
-		fmt.Println(m.getPrompt(), confirmationText)
+		fmt.Println(m.getPrompt(), buttons[shown].label)
 	}
 
-	return m.confirmation, nil
+	return m.chosen, nil
+}
+
+// answer chooses the button from the recording or the answers file, or the
+// affirmative one when assuming yes, unless its text must be typed. No answer
+// quits the prompt.
+func (o Options) answer(buttons []button, prompt answer.Prompt) (int, bool, error) {
+	answers, ok, err := answer.Lookup(prompt)
+	if err != nil {
+		return -1, false, err
+	}
+	if ok {
+		switch len(answers) {
+		case 0:
+			return -1, true, nil
+		case 1:
+		default:
+			return -1, false, fmt.Errorf("expected one answer to %q, got %d", prompt.ID, len(answers))
+		}
+		i, err := o.choice(buttons, answers[0])
+		return i, true, err
+	}
+	return 0, o.RequireText == "" && answer.Yes(), nil
+}
+
+// choice returns the button answered by the line: its label, or yes or no
+// for the affirmative and negative buttons. An empty line is negative, and
+// custom buttons must be named.
+func (o Options) choice(buttons []button, line string) (int, error) {
+	line = strings.TrimSpace(line)
+	if o.RequireText != "" {
+		// The first button is chosen by typing the text only.
+		if line == o.RequireText {
+			return 0, nil
+		}
+		if i := find(buttons[1:], line); i >= 0 {
+			return i + 1, nil
+		}
+		return -1, nil
+	}
+	if i := find(buttons, line); i >= 0 {
+		return i, nil
+	}
+	if len(o.Buttons) > 0 {
+		return -1, fmt.Errorf("no button is labeled %q", line)
+	}
+	negative := -1
+	if len(buttons) > 1 {
+		negative = 1
+	}
+	if line == "" {
+		return negative, nil
+	}
+	yes, err := answer.ParseBool(line)
+	if err != nil {
+		return -1, fmt.Errorf("invalid answer: %w", err)
+	}
+	if yes {
+		return 0, nil
+	}
+	return negative, nil
+}
+
+// buttons returns the custom buttons, or the affirmative and negative ones.
+func (o Options) buttons() ([]button, error) {
+	if len(o.Buttons) == 0 {
+		return defaultButtons(o.Affirmative, o.Negative), nil
+	}
+	keys, err := o.keymap()
+	if err != nil {
+		return nil, err
+	}
+	return parseButtons(o.Buttons, keys)
+}
+
+// keymap returns the key bindings, remapped.
+func (o Options) keymap() (keymap, error) {
+	keys := defaultKeymap()
+	if err := bindings.Remap("confirm", o.Keymap, &keys); err != nil {
+		return keymap{}, err
+	}
+	return keys, nil
 }
@@ -8,17 +8,25 @@
 // I.e. confirm if the user wants to delete a file
 //
 // $ gum confirm "Are you sure?" && rm file.txt
+//
+// Other buttons can be given, each exiting with its own code.
+//
+// $ gum confirm "Deploy?" --button Yes --button No --button "Yes to all" --button Cancel
 package confirm
 
 import (
+	"time"
+
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/gum/internal/mouse"
 	"github.com/charmbracelet/lipgloss"
 )
 
-func defaultKeymap(affirmative, negative string) keymap {
+func defaultKeymap() keymap {
 	return keymap{
 		Abort: key.NewBinding(
 			key.WithKeys("ctrl+c"),
@@ -28,27 +36,13 @@ func defaultKeymap(affirmative, negative string) keymap {
 			key.WithKeys("esc"),
 			key.WithHelp("esc", "quit"),
 		),
-		Negative: key.NewBinding(
-			key.WithKeys("n", "N", "q"),
-			key.WithHelp("n", negative),
-		),
-		Affirmative: key.NewBinding(
-			key.WithKeys("y", "Y"),
-			key.WithHelp("y", affirmative),
-		),
-		Toggle: key.NewBinding(
-			key.WithKeys(
-				"left",
-				"h",
-				"ctrl+n",
-				"shift+tab",
-				"right",
-				"l",
-				"ctrl+p",
-				"tab",
-			),
+		Prev: key.NewBinding(
+			key.WithKeys("left", "h", "ctrl+p", "shift+tab"),
 			key.WithHelp("←→", "toggle"),
 		),
+		Next: key.NewBinding(
+			key.WithKeys("right", "l", "ctrl+n", "tab"),
+		),
 		Submit: key.NewBinding(
 			key.WithKeys("enter"),
 			key.WithHelp("enter", "submit"),
@@ -57,12 +51,12 @@ func defaultKeymap(affirmative, negative string) keymap {
 }
 
 type keymap struct {
-	Abort       key.Binding
-	Quit        key.Binding
-	Negative    key.Binding
-	Affirmative key.Binding
-	Toggle      key.Binding
-	Submit      key.Binding
+	Abort   key.Binding
+	Quit    key.Binding
+	Prev    key.Binding
+	Next    key.Binding
+	Submit  key.Binding
+	Buttons []key.Binding
 }
 
 // FullHelp implements help.KeyMap.
@@ -70,23 +64,35 @@ func (k keymap) FullHelp() [][]key.Binding { return nil }
 
 // ShortHelp implements help.KeyMap.
 func (k keymap) ShortHelp() []key.Binding {
-	return []key.Binding{k.Toggle, k.Submit, k.Affirmative, k.Negative}
+	return append([]key.Binding{k.Prev, k.Submit}, k.Buttons...)
 }
 
 type model struct {
-	prompt      string
-	promptFn    func() string
-	affirmative string
-	negative    string
-	quitting    bool
-	showHelp    bool
-	help        help.Model
-	keys        keymap
-
-	showOutput   bool
-	confirmation bool
-
-	defaultSelection bool
+	prompt   string
+	promptFn func() string
+	buttons  []button
+	quitting bool
+	showHelp bool
+	help     help.Model
+	keys     keymap
+
+	// requireText must be typed in text to choose the first button, and
+	// hold is how long its key must be held.
+	requireText string
+	text        textinput.Model
+	hold        time.Duration
+	holdStart   time.Time
+	lastPress   time.Time
+
+	showOutput bool
+	// cursor is the selected button, and chosen the one answered, or -1 if
+	// the prompt was quit.
+	cursor int
+	chosen int
+
+	// mouse locates the view to map clicks to buttons, if the mouse is
+	// enabled.
+	mouse *mouse.Tracker
 
 	// styles
 	promptStyle     lipgloss.Style
@@ -104,71 +110,101 @@ func (m model) getPrompt() string {
 func (m model) Init() tea.Cmd { return nil }
 
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	m.mouse.Update(msg)
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
 		return m, nil
+	case tea.MouseMsg:
+		return m.updateMouse(msg)
+	case holdTickMsg:
+		return m.updateHold(time.Time(msg))
 	case tea.KeyMsg:
 		switch {
 		case key.Matches(msg, m.keys.Abort):
-			m.confirmation = false
+			m.chosen = -1
 			return m, tea.Interrupt
 		case key.Matches(msg, m.keys.Quit):
-			m.confirmation = false
-			m.quitting = true
-			return m, tea.Quit
-		case key.Matches(msg, m.keys.Negative):
-			m.confirmation = false
+			m.chosen = -1
 			m.quitting = true
 			return m, tea.Quit
-		case key.Matches(msg, m.keys.Toggle):
-			if m.negative == "" {
-				break
-			}
-			m.confirmation = !m.confirmation
+		case key.Matches(msg, m.keys.Prev):
+			m.cursor = (m.cursor + len(m.buttons) - 1) % len(m.buttons)
+			return m, nil
+		case key.Matches(msg, m.keys.Next):
+			m.cursor = (m.cursor + 1) % len(m.buttons)
+			return m, nil
 		case key.Matches(msg, m.keys.Submit):
+			if m.cursor == 0 {
+				return m.pressFirst()
+			}
+			m.chosen = m.cursor
 			m.quitting = true
 			return m, tea.Quit
-		case key.Matches(msg, m.keys.Affirmative):
+		}
+		for i, b := range m.buttons {
+			if !key.Matches(msg, b.key) {
+				continue
+			}
+			m.cursor = i
+			if i == 0 {
+				return m.pressFirst()
+			}
+			m.chosen = i
 			m.quitting = true
-			m.confirmation = true
 			return m, tea.Quit
 		}
+		if m.requireText != "" {
+			var cmd tea.Cmd
+			m.text, cmd = m.text.Update(msg)
+			return m, cmd
+		}
 	}
 	return m, nil
 }
 
+func (m model) pressFirst() (tea.Model, tea.Cmd) {
+	if !m.unlocked() {
+		return m, nil
+	}
+	return m.press(time.Now())
+}
+
 func (m model) View() string {
 	if m.quitting {
 		return ""
 	}
 
-	var aff, neg string
-
-	if m.confirmation {
-		aff = m.selectedStyle.Render(m.affirmative)
-		neg = m.unselectedStyle.Render(m.negative)
-	} else {
-		aff = m.unselectedStyle.Render(m.affirmative)
-		neg = m.selectedStyle.Render(m.negative)
+	parts := append(m.promptViews(), lipgloss.JoinHorizontal(lipgloss.Left, m.buttonViews()...))
+	if m.showHelp {
+		parts = append(parts, "\n"+m.help.View(m.keys))
 	}
+	return m.mouse.Render(lipgloss.JoinVertical(lipgloss.Left, parts...))
+}
 
-	// If the option is intentionally empty, do not show it.
-	if m.negative == "" {
-		neg = ""
+// promptViews renders the prompt, and the text to type if any, shown above
+// the buttons.
+func (m model) promptViews() []string {
+	parts := []string{m.promptStyle.Render(m.getPrompt()) + "\n"}
+	if m.requireText != "" {
+		// Align the text with the prompt.
+		parts = append(parts, lipgloss.NewStyle().
+			MarginLeft(m.promptStyle.GetMarginLeft()).
+			Render("Type "+m.requireText+" to confirm:\n"+m.text.View()+"\n"))
 	}
+	return parts
+}
 
-	if m.showHelp {
-		return lipgloss.JoinVertical(
-			lipgloss.Left,
-			m.promptStyle.Render(m.getPrompt())+"\n",
-			lipgloss.JoinHorizontal(lipgloss.Left, aff, neg),
-			"\n"+m.help.View(m.keys),
-		)
+// buttonViews renders the buttons, the one under the cursor selected.
+func (m model) buttonViews() []string {
+	buttons := make([]string, len(m.buttons))
+	for i, b := range m.buttons {
+		if i == 0 {
+			buttons[i] = m.firstButtonView()
+		} else if i == m.cursor {
+			buttons[i] = m.selectedStyle.Render(b.label)
+		} else {
+			buttons[i] = m.unselectedStyle.Render(b.label)
+		}
 	}
-
-	return lipgloss.JoinVertical(
-		lipgloss.Left,
-		m.promptStyle.Render(m.getPrompt())+"\n",
-		lipgloss.JoinHorizontal(lipgloss.Left, aff, neg),
-	)
+	return buttons
 }
@@ -0,0 +1,81 @@
+package confirm
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// holdRelease is how long after the last key repeat the key is considered
+// released. Terminals only report key repeats, the first one coming after
+// about half a second.
+const holdRelease = 700 * time.Millisecond
+
+type holdTickMsg time.Time
+
+func holdTick() tea.Cmd {
+	return tea.Tick(holdRelease/4, func(t time.Time) tea.Msg { return holdTickMsg(t) })
+}
+
+// unlocked reports whether the first button can be chosen, once the
+// required text is typed.
+func (m model) unlocked() bool {
+	return m.requireText == "" || m.text.Value() == m.requireText
+}
+
+// holding reports whether the first button is being held.
+func (m model) holding() bool {
+	return !m.holdStart.IsZero()
+}
+
+// press handles a press of the first button, which must be held to choose it
+// when there is a hold duration.
+func (m model) press(now time.Time) (model, tea.Cmd) {
+	if m.hold <= 0 {
+		m.chosen = 0
+		m.quitting = true
+		return m, tea.Quit
+	}
+	var cmd tea.Cmd
+	if !m.holding() || now.Sub(m.lastPress) > holdRelease {
+		m.holdStart = now
+		cmd = holdTick()
+	}
+	m.lastPress = now
+	if m.lastPress.Sub(m.holdStart) >= m.hold {
+		m.chosen = 0
+		m.quitting = true
+		return m, tea.Quit
+	}
+	return m, cmd
+}
+
+// updateHold releases the first button when its key is not repeated anymore.
+func (m model) updateHold(now time.Time) (model, tea.Cmd) {
+	if !m.holding() {
+		return m, nil
+	}
+	if now.Sub(m.lastPress) > holdRelease {
+		m.holdStart = time.Time{}
+		return m, nil
+	}
+	return m, holdTick()
+}
+
+// firstButtonView renders the first button, locked until the required text
+// is typed, and filling up while it is held.
+func (m model) firstButtonView() string {
+	label := m.buttons[0].label
+	if !m.unlocked() || m.cursor != 0 && !m.holding() {
+		return m.unselectedStyle.Render(label)
+	}
+	selected := m.selectedStyle.Render(label)
+	if !m.holding() {
+		return selected
+	}
+	unselected := m.unselectedStyle.Render(label)
+	width := ansi.StringWidth(selected)
+	filled := int(float64(width) * min(1, float64(m.lastPress.Sub(m.holdStart))/float64(m.hold)))
+	return ansi.Cut(selected, 0, filled) + ansi.Cut(unselected, filled, width)
+}
@@ -0,0 +1,43 @@
+package confirm
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// updateMouse presses the button clicked, as its key would.
+func (m model) updateMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	if msg.Action != tea.MouseActionPress || msg.Button != tea.MouseButtonLeft {
+		return m, nil
+	}
+	i := m.buttonAt(msg.X, m.mouse.Line(msg))
+	switch {
+	case i < 0:
+		return m, nil
+	case i == 0:
+		m.cursor = 0
+		return m.pressFirst()
+	}
+	m.cursor = i
+	m.chosen = i
+	m.quitting = true
+	return m, tea.Quit
+}
+
+// buttonAt returns the index of the button shown at the given column and
+// line of the view, or -1.
+func (m model) buttonAt(x, line int) int {
+	for _, part := range m.promptViews() {
+		line -= lipgloss.Height(part)
+	}
+	if line != 0 {
+		return -1
+	}
+	for i, b := range m.buttonViews() {
+		if x < lipgloss.Width(b) {
+			return i
+		}
+		x -= lipgloss.Width(b)
+	}
+	return -1
+}
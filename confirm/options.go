@@ -13,6 +13,13 @@ type Options struct {
 	Affirmative string `help:"The title of the affirmative action" default:"Yes"`
 	Negative    string `help:"The title of the negative action" default:"No"`
 	Prompt      string `arg:"" help:"Prompt to display." default:"Are you sure?"`
+	ID          string `help:"Id of the prompt in the answers file (defaults to the prompt)" default:""`
+
+	Buttons       []string `name:"button" help:"Button to show instead of the affirmative and negative ones, as label[:exitcode] (exit codes default to the button's position, and quitting exits with 130); repeat for several buttons" sep:"none" group:"Buttons"`
+	DefaultButton string   `help:"Label of the button selected at first" default:"" env:"GUM_CONFIRM_DEFAULT_BUTTON" group:"Buttons"`
+
+	RequireText string        `help:"Text to type before the affirmative (or first) button can be chosen" default:"" env:"GUM_CONFIRM_REQUIRE_TEXT" group:"Safety"`
+	Hold        time.Duration `help:"How long to hold the key of the affirmative (or first) button to choose it" default:"0s" env:"GUM_CONFIRM_HOLD" group:"Safety"`
 
 	PromptFn func() string `kong:"-"`
 
@@ -21,7 +28,9 @@ type Options struct {
 	//nolint:staticcheck
 	SelectedStyle style.Styles `embed:"" prefix:"selected." help:"The style of the selected action" set:"defaultBackground=212" set:"defaultForeground=230" set:"defaultPadding=0 3" set:"defaultMargin=0 1" envprefix:"GUM_CONFIRM_SELECTED_"`
 	//nolint:staticcheck
-	UnselectedStyle style.Styles  `embed:"" prefix:"unselected." help:"The style of the unselected action" set:"defaultBackground=235" set:"defaultForeground=254" set:"defaultPadding=0 3" set:"defaultMargin=0 1" envprefix:"GUM_CONFIRM_UNSELECTED_"`
-	ShowHelp        bool          `help:"Show help key binds" negatable:"" default:"true" env:"GUM_CONFIRM_SHOW_HELP"`
-	Timeout         time.Duration `help:"Timeout until confirm returns selected value or default if provided" default:"0s" env:"GUM_CONFIRM_TIMEOUT"`
+	UnselectedStyle style.Styles      `embed:"" prefix:"unselected." help:"The style of the unselected action" set:"defaultBackground=235" set:"defaultForeground=254" set:"defaultPadding=0 3" set:"defaultMargin=0 1" envprefix:"GUM_CONFIRM_UNSELECTED_"`
+	ShowHelp        bool              `help:"Show help key binds" negatable:"" default:"true" env:"GUM_CONFIRM_SHOW_HELP"`
+	Keymap          map[string]string `help:"Remap key bindings, as action=keys (e.g. down=ctrl+j,down)" placeholder:"action=keys;..." env:"GUM_CONFIRM_KEYMAP"`
+	Mouse           bool              `help:"Enable the mouse, to click and scroll with the wheel" env:"GUM_CONFIRM_MOUSE"`
+	Timeout         time.Duration     `help:"Timeout until confirm returns selected value or default if provided" default:"0s" env:"GUM_CONFIRM_TIMEOUT"`
 }
@@ -0,0 +1,55 @@
+package date
+
+import (
+	"time"
+
+	"github.com/charmbracelet/gum/internal/accessible"
+	"github.com/charmbracelet/gum/internal/calendar"
+)
+
+// ask reads the dates typed, asking again until they are within the bounds.
+// Nothing typed picks the initial date.
+func (m model) ask(parse func(string) (time.Time, error)) ([]time.Time, error) {
+	if m.header != "" {
+		accessible.Println(m.header)
+	}
+	initial := m.calendar.Cursor()
+	if m.withTime {
+		h, mi, s := m.clock.Time().Clock()
+		initial = time.Date(initial.Year(), initial.Month(), initial.Day(), h, mi, s, 0, initial.Location())
+	}
+	prompts := []string{"Date"}
+	if m.count == 2 {
+		prompts = []string{"Start", "End"}
+	}
+
+	var picked []time.Time
+	for _, prompt := range prompts {
+		for {
+			line, err := accessible.ReadLine(prompt + " [" + initial.Format(m.layout) + "]: ")
+			if err != nil {
+				return nil, err
+			}
+			t := initial
+			if line != "" {
+				if t, err = parse(line); err != nil {
+					accessible.Println("Invalid date: " + err.Error())
+					continue
+				}
+			}
+			if !m.calendar.Enabled(t) {
+				accessible.Println("Invalid date: out of range")
+				continue
+			}
+			if !m.withTime {
+				t = calendar.Day(t)
+			}
+			picked = append(picked, t)
+			break
+		}
+	}
+	if len(picked) == 2 && picked[1].Before(picked[0]) {
+		picked[0], picked[1] = picked[1], picked[0]
+	}
+	return picked, nil
+}
@@ -0,0 +1,71 @@
+package date
+
+import (
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/gum/bingoo"
+	"github.com/charmbracelet/gum/internal/tty"
+)
+
+func Timeout(timeout time.Duration) func(*Options) {
+	return func(o *Options) { o.Timeout = timeout }
+}
+
+func Header(header string) func(*Options) {
+	return func(o *Options) { o.Header = header }
+}
+
+// Bounds sets the earliest and latest dates that can be picked, zero values
+// leaving the bound open.
+func Bounds(lo, hi time.Time) func(*Options) {
+	return func(o *Options) {
+		if !lo.IsZero() {
+			o.Min = lo.Format(time.RFC3339)
+		}
+		if !hi.IsZero() {
+			o.Max = hi.Format(time.RFC3339)
+		}
+	}
+}
+
+// Range picks the start and the end of a range.
+func Range() func(*Options) {
+	return func(o *Options) { o.Range = true }
+}
+
+// WithTime picks a time of day too.
+func WithTime() func(*Options) {
+	return func(o *Options) { o.Time = true }
+}
+
+// WeekStart sets the first day of the week.
+func WeekStart(day time.Weekday) func(*Options) {
+	return func(o *Options) { o.WeekStart = day.String() }
+}
+
+// Date lets the user pick a date, or the start and the end of a range.
+func Date(optionsFn ...func(*Options)) ([]time.Time, error) {
+	option := &Options{}
+	bingoo.KongParse(option, bingoo.KongVars)
+
+	for _, fn := range optionsFn {
+		fn(option)
+	}
+	return option.RunBingoo()
+}
+
+// Run provides a shell script interface for picking dates.
+func (o Options) Run() error {
+	picked, err := o.RunBingoo()
+	if err != nil {
+		return err
+	}
+
+	out := make([]string, len(picked))
+	for i, t := range picked {
+		out[i] = t.Format(o.layout())
+	}
+	tty.Println(strings.Join(out, o.OutputDelimiter))
+	return nil
+}
@@ -0,0 +1,111 @@
+package date
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/help"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/gum/internal/accessible"
+	"github.com/charmbracelet/gum/internal/bindings"
+	"github.com/charmbracelet/gum/internal/calendar"
+	"github.com/charmbracelet/gum/internal/timeformat"
+	"github.com/charmbracelet/gum/internal/timeout"
+)
+
+// layout returns the output layout.
+func (o Options) layout() string {
+	switch {
+	case o.Format != "":
+		return timeformat.Layout(o.Format)
+	case o.Time:
+		return "2006-01-02 15:04"
+	default:
+		return time.DateOnly
+	}
+}
+
+// parse parses the --value, --min and --max flags.
+func (o Options) parse(value string) (time.Time, error) {
+	return timeformat.Parse(value, o.layout(), time.DateOnly, time.DateTime, "2006-01-02 15:04", time.RFC3339)
+}
+
+// RunBingoo lets the user pick a date, or the start and the end of a range.
+func (o Options) RunBingoo() ([]time.Time, error) {
+	layout := o.layout()
+	value := time.Now()
+	if o.Value != "" {
+		var err error
+		if value, err = o.parse(o.Value); err != nil {
+			return nil, fmt.Errorf("invalid value: %w", err)
+		}
+	}
+
+	c := calendar.New(value)
+	weekStart, err := calendar.ParseWeekday(o.WeekStart)
+	if err != nil {
+		return nil, err
+	}
+	c.WeekStart = weekStart
+	if o.Min != "" {
+		if c.Min, err = o.parse(o.Min); err != nil {
+			return nil, fmt.Errorf("invalid minimum: %w", err)
+		}
+	}
+	if o.Max != "" {
+		if c.Max, err = o.parse(o.Max); err != nil {
+			return nil, fmt.Errorf("invalid maximum: %w", err)
+		}
+	}
+	c.SetCursor(value)
+	c.Styles.Cursor = o.CursorStyle.ToLipgloss()
+	c.Styles.Range = o.RangeStyle.ToLipgloss()
+
+	clock := calendar.NewClock(value.Truncate(time.Minute))
+	clock.Seconds = strings.Contains(layout, "05")
+	clock.Cursor = c.Styles.Cursor
+
+	count := 1
+	if o.Range {
+		count = 2
+	}
+
+	m := model{
+		calendar:    c,
+		clock:       clock,
+		count:       count,
+		withTime:    o.Time,
+		layout:      layout,
+		header:      o.Header,
+		headerStyle: o.HeaderStyle.ToLipgloss(),
+		showHelp:    o.ShowHelp,
+		help:        help.New(),
+		keymap:      defaultKeymap(),
+	}
+	if err := bindings.Remap("date", o.Keymap, &m.keymap, &m.calendar.KeyMap, &m.clock.KeyMap); err != nil {
+		return nil, err
+	}
+	if accessible.On() {
+		return m.ask(o.parse)
+	}
+
+	ctx, cancel := timeout.Context(o.Timeout)
+	defer cancel()
+
+	tm, err := tea.NewProgram(
+		m,
+		tea.WithOutput(os.Stderr),
+		tea.WithContext(ctx),
+	).Run()
+	if err != nil {
+		return nil, fmt.Errorf("unable to pick a date: %w", err)
+	}
+	m = tm.(model)
+	if !m.submitted {
+		return nil, errors.New("nothing selected")
+	}
+	return m.picked, nil
+}
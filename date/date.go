@@ -0,0 +1,141 @@
+// Package date provides a calendar to pick a date, a time of day or a range
+// of dates.
+//
+// $ gum date --range --format rfc3339
+package date
+
+import (
+	"time"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/gum/internal/calendar"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type keymap struct {
+	Submit key.Binding
+	Abort  key.Binding
+}
+
+func defaultKeymap() keymap {
+	return keymap{
+		Submit: key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "pick")),
+		Abort:  key.NewBinding(key.WithKeys("esc", "ctrl+c"), key.WithHelp("esc", "cancel")),
+	}
+}
+
+// ShortHelp implements help.KeyMap.
+func (k keymap) ShortHelp() []key.Binding { return []key.Binding{k.Submit, k.Abort} }
+
+// FullHelp implements help.KeyMap.
+func (k keymap) FullHelp() [][]key.Binding { return nil }
+
+type model struct {
+	calendar calendar.Model
+	clock    calendar.Clock
+
+	// count is the number of dates to pick, 2 for a range. Each date is
+	// followed by its time of day when withTime is set.
+	count       int
+	withTime    bool
+	pickingTime bool
+	picked      []time.Time
+	layout      string
+
+	header      string
+	headerStyle lipgloss.Style
+	showHelp    bool
+	help        help.Model
+	keymap      keymap
+
+	quitting  bool
+	submitted bool
+}
+
+func (m model) Init() tea.Cmd { return nil }
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+	switch {
+	case keyMsg.String() == "ctrl+c":
+		m.quitting = true
+		return m, tea.Interrupt
+	case key.Matches(keyMsg, m.keymap.Abort):
+		m.quitting = true
+		return m, tea.Quit
+	case key.Matches(keyMsg, m.keymap.Submit):
+		return m.pick()
+	}
+
+	if m.pickingTime {
+		m.clock, _ = m.clock.Update(msg)
+	} else {
+		m.calendar, _ = m.calendar.Update(msg)
+	}
+	return m, nil
+}
+
+// pick picks the date under the cursor, or the time of day being edited, and
+// moves on to the next thing to pick.
+func (m model) pick() (tea.Model, tea.Cmd) {
+	switch {
+	case m.pickingTime:
+		m.pickingTime = false
+		m.picked = append(m.picked, m.clock.Time())
+	case m.withTime:
+		// Pick the time of day of that date next.
+		day := m.calendar.Cursor()
+		h, mi, s := m.clock.Time().Clock()
+		m.clock.SetTime(time.Date(day.Year(), day.Month(), day.Day(), h, mi, s, 0, day.Location()))
+		m.pickingTime = true
+		return m, nil
+	default:
+		m.picked = append(m.picked, m.calendar.Cursor())
+	}
+
+	if len(m.picked) < m.count {
+		m.calendar.Anchor = m.picked[0]
+		return m, nil
+	}
+	if m.count == 2 && m.picked[1].Before(m.picked[0]) {
+		m.picked[0], m.picked[1] = m.picked[1], m.picked[0]
+	}
+	m.quitting = true
+	m.submitted = true
+	return m, tea.Quit
+}
+
+func (m model) View() string {
+	if m.quitting {
+		return ""
+	}
+
+	var parts []string
+	if m.header != "" {
+		parts = append(parts, m.headerStyle.Render(m.header))
+	}
+
+	var value string
+	for _, t := range m.picked {
+		value += t.Format(m.layout) + " → "
+	}
+	var keys []key.Binding
+	if m.pickingTime {
+		day := m.calendar.Cursor().Format(time.DateOnly)
+		parts = append(parts, value+day+" "+m.clock.View())
+		keys = m.clock.KeyMap.ShortHelp()
+	} else {
+		parts = append(parts, value+m.calendar.Cursor().Format(m.layout), "", m.calendar.View())
+		keys = m.calendar.KeyMap.ShortHelp()
+	}
+
+	if m.showHelp {
+		parts = append(parts, "", m.help.ShortHelpView(append(keys, m.keymap.ShortHelp()...)))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, parts...)
+}
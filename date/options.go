@@ -0,0 +1,27 @@
+package date
+
+import (
+	"time"
+
+	"github.com/charmbracelet/gum/style"
+)
+
+// Options is the customization options for the date command.
+type Options struct {
+	Value           string            `help:"Initial date (in --format, YYYY-MM-DD or RFC 3339)" default:"" env:"GUM_DATE_VALUE"`
+	Min             string            `help:"Earliest date that can be picked" default:"" env:"GUM_DATE_MIN"`
+	Max             string            `help:"Latest date that can be picked" default:"" env:"GUM_DATE_MAX"`
+	Range           bool              `help:"Pick a range of dates, the start and the end are printed" default:"false" env:"GUM_DATE_RANGE"`
+	Time            bool              `help:"Pick a time of day too" default:"false" env:"GUM_DATE_TIME"`
+	Format          string            `help:"Output layout, either a Go layout or a name (dateonly, datetime, rfc3339, kitchen, ...)" default:"" env:"GUM_DATE_FORMAT"`
+	WeekStart       string            `help:"First day of the week" enum:"sunday,monday,tuesday,wednesday,thursday,friday,saturday" default:"sunday" env:"GUM_DATE_WEEK_START"`
+	OutputDelimiter string            `help:"Delimiter between the start and the end of a range" default:"\n" env:"GUM_DATE_OUTPUT_DELIMITER"`
+	Header          string            `help:"Header value" default:"" env:"GUM_DATE_HEADER"`
+	ShowHelp        bool              `help:"Show help keybinds" default:"true" negatable:"" env:"GUM_DATE_SHOW_HELP"`
+	Keymap          map[string]string `help:"Remap key bindings, as action=keys (e.g. down=ctrl+j,down)" placeholder:"action=keys;..." env:"GUM_DATE_KEYMAP"`
+	Timeout         time.Duration     `help:"Timeout until date aborts" default:"0s" env:"GUM_DATE_TIMEOUT"`
+
+	HeaderStyle style.Styles `embed:"" prefix:"header." set:"defaultForeground=99" envprefix:"GUM_DATE_HEADER_"`
+	CursorStyle style.Styles `embed:"" prefix:"cursor." set:"defaultForeground=230" set:"defaultBackground=212" envprefix:"GUM_DATE_CURSOR_"` //nolint:staticcheck
+	RangeStyle  style.Styles `embed:"" prefix:"range." set:"defaultForeground=212" envprefix:"GUM_DATE_RANGE_"`
+}
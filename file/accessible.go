@@ -0,0 +1,95 @@
+package file
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/gum/internal/accessible"
+)
+
+// ask lists the entries of the directory numbered, and reads the numbers of
+// the picked ones. A directory that cannot be picked is opened by its
+// number, any directory by its number followed by a slash, and the parent
+// directory by 0.
+func (m model) ask() ([]string, error) {
+	if m.header != "" {
+		accessible.Println(m.header)
+	}
+	for {
+		accessible.Println("In " + m.dir + ":")
+		accessible.Println(accessible.Item(0, ".."))
+		for i, e := range m.entries {
+			name := e.name
+			if e.isDir {
+				name += "/"
+			}
+			accessible.Println(accessible.Item(i+1, name))
+		}
+
+		prompt := fmt.Sprintf("Choose from 1 to %d (n/ opens a directory, 0 the parent): ", len(m.entries))
+		if m.limit > 1 {
+			prompt = fmt.Sprintf("Choose up to %d from 1 to %d, separated by spaces (n/ opens a directory, 0 the parent): ", min(m.limit, len(m.entries)), len(m.entries))
+		}
+		for {
+			line, err := accessible.ReadLine(prompt)
+			if err != nil {
+				return nil, err
+			}
+			dir, paths, err := m.answerLine(strings.TrimSpace(line))
+			if err != nil {
+				accessible.Println(err.Error())
+				continue
+			}
+			if len(paths) > 0 {
+				return paths, nil
+			}
+			next := m.open(dir, "")
+			if next.err != nil {
+				accessible.Println(next.err.Error())
+				continue
+			}
+			m = next
+			break
+		}
+	}
+}
+
+// answerLine returns the directory to open, or else the paths picked by the
+// line.
+func (m model) answerLine(line string) (string, []string, error) {
+	switch line {
+	case "":
+		return "", nil, errors.New("type the numbers of entries, a directory number followed by / to open it, or 0 for the parent directory")
+	case "0", "..":
+		return filepath.Dir(m.dir), nil, nil
+	}
+	if number, ok := strings.CutSuffix(line, "/"); ok {
+		i, err := strconv.Atoi(number)
+		if err != nil || i < 1 || i > len(m.entries) || !m.entries[i-1].isDir {
+			return "", nil, fmt.Errorf("%q is not the number of a directory", number)
+		}
+		return filepath.Join(m.dir, m.entries[i-1].name), nil, nil
+	}
+
+	indices, err := accessible.ParseNumbers(line, len(m.entries), m.limit)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(indices) == 1 {
+		if e := m.entries[indices[0]]; e.isDir && !m.selectable(e) {
+			return filepath.Join(m.dir, e.name), nil, nil
+		}
+	}
+	paths := make([]string, len(indices))
+	for i, index := range indices {
+		e := m.entries[index]
+		if !m.selectable(e) {
+			return "", nil, fmt.Errorf("%d cannot be chosen", index+1)
+		}
+		paths[i] = filepath.Join(m.dir, e.name)
+	}
+	return "", paths, nil
+}
@@ -0,0 +1,90 @@
+package file
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/filepicker"
+	"github.com/sahilm/fuzzy"
+)
+
+// entry is a file of the current directory.
+type entry struct {
+	name string
+	info os.FileInfo
+	// isDir is also set for symlinks to directories.
+	isDir bool
+	// link is the target of symlinks.
+	link string
+}
+
+// readDir lists the directory, directories first.
+func readDir(dir string, showHidden bool) ([]entry, error) {
+	dirEntries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read directory: %w", err)
+	}
+
+	entries := make([]entry, 0, len(dirEntries))
+	for _, de := range dirEntries {
+		if hidden, _ := filepicker.IsHidden(de.Name()); hidden && !showHidden {
+			continue
+		}
+		info, err := de.Info()
+		if err != nil {
+			continue
+		}
+		e := entry{name: de.Name(), info: info, isDir: de.IsDir()}
+		if info.Mode()&os.ModeSymlink != 0 {
+			e.link, _ = filepath.EvalSymlinks(filepath.Join(dir, de.Name()))
+			if target, err := os.Stat(e.link); err == nil {
+				e.isDir = target.IsDir()
+			}
+		}
+		entries = append(entries, e)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].isDir == entries[j].isDir {
+			return entries[i].name < entries[j].name
+		}
+		return entries[i].isDir
+	})
+	return entries, nil
+}
+
+// allowed reports whether the file name matches one of the types, which are
+// either extensions (".go") or globs ("*_test.go"). All files are allowed if
+// there are no types.
+func allowed(name string, types []string) bool {
+	if len(types) == 0 {
+		return true
+	}
+	for _, t := range types {
+		if strings.ContainsAny(t, "*?[") {
+			if ok, _ := filepath.Match(t, name); ok {
+				return true
+			}
+		} else if strings.HasSuffix(name, t) {
+			return true
+		}
+	}
+	return false
+}
+
+// jump returns the index of the entry whose name best matches the query, or
+// -1 if none does.
+func jump(entries []entry, query string) int {
+	names := make([]string, len(entries))
+	for i, e := range entries {
+		names[i] = e.name
+	}
+	matches := fuzzy.Find(query, names)
+	if len(matches) == 0 {
+		return -1
+	}
+	return matches[0].Index
+}
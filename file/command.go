@@ -1,15 +1,22 @@
 package file
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/filepicker"
 	"github.com/charmbracelet/bubbles/help"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/glamour/styles"
+	"github.com/charmbracelet/gum/internal/accessible"
+	"github.com/charmbracelet/gum/internal/bindings"
 	"github.com/charmbracelet/gum/internal/timeout"
+	"github.com/muesli/termenv"
 )
 
 // Run is the interface to picking a file.
@@ -27,39 +34,72 @@ func (o Options) Run() error {
 		return fmt.Errorf("file not found: %w", err)
 	}
 
-	fp := filepicker.New()
-	fp.CurrentDirectory = path
-	fp.Path = path
-	fp.Height = o.Height
-	fp.AutoHeight = o.Height == 0
-	fp.Cursor = o.Cursor
-	fp.DirAllowed = o.Directory
-	fp.FileAllowed = o.File
-	fp.ShowPermissions = o.Permissions
-	fp.ShowSize = o.Size
-	fp.ShowHidden = o.All
-	fp.Styles = filepicker.DefaultStyles()
-	fp.Styles.Cursor = o.CursorStyle.ToLipgloss()
-	fp.Styles.Symlink = o.SymlinkStyle.ToLipgloss()
-	fp.Styles.Directory = o.DirectoryStyle.ToLipgloss()
-	fp.Styles.File = o.FileStyle.ToLipgloss()
-	fp.Styles.Permission = o.PermissionsStyle.ToLipgloss()
-	fp.Styles.Selected = o.SelectedStyle.ToLipgloss()
-	fp.Styles.FileSize = o.FileSizeStyle.ToLipgloss()
+	fpStyles := filepicker.DefaultStyles()
+	fpStyles.Cursor = o.CursorStyle.ToLipgloss()
+	fpStyles.Symlink = o.SymlinkStyle.ToLipgloss()
+	fpStyles.Directory = o.DirectoryStyle.ToLipgloss()
+	fpStyles.File = o.FileStyle.ToLipgloss()
+	fpStyles.Permission = o.PermissionsStyle.ToLipgloss()
+	fpStyles.Selected = o.SelectedStyle.ToLipgloss()
+	fpStyles.FileSize = o.FileSizeStyle.ToLipgloss()
+
+	if o.NoLimit {
+		o.Limit = math.MaxInt
+	}
+	km := defaultKeymap()
+	if err := bindings.Remap("file", o.Keymap, &km); err != nil {
+		return err
+	}
+	if o.Limit > 1 {
+		km.Toggle.SetEnabled(true)
+	}
+
 	m := model{
-		filepicker:  fp,
-		showHelp:    o.ShowHelp,
-		help:        help.New(),
-		keymap:      defaultKeymap(),
-		headerStyle: o.HeaderStyle.ToLipgloss(),
-		header:      o.Header,
+		showHelp:         o.ShowHelp,
+		help:             help.New(),
+		keymap:           km,
+		headerStyle:      o.HeaderStyle.ToLipgloss(),
+		header:           o.Header,
+		height:           o.Height,
+		autoHeight:       o.Height == 0,
+		showHidden:       o.All,
+		showPermissions:  o.Permissions,
+		showSize:         o.Size,
+		fileAllowed:      o.File,
+		dirAllowed:       o.Directory,
+		allowedTypes:     o.AllowedTypes,
+		cursorChar:       o.Cursor,
+		selectedPrefix:   o.SelectedPrefix,
+		unselectedPrefix: o.UnselectedPrefix,
+		styles:           fpStyles,
+		limit:            max(1, o.Limit),
+		showPreview:      o.Preview,
+		previewMaxSize:   o.PreviewMaxSize,
+		previewStyle:     o.PreviewStyle.ToLipgloss(),
+		codeStyle:        styles.LightStyle,
+	}
+	// The preview is rendered for the terminal of the interface rather than
+	// for the output, and it can't be queried once the interface runs.
+	if termenv.NewOutput(os.Stderr).HasDarkBackground() {
+		m.codeStyle = styles.DarkStyle
+	}
+	m = m.open(path, "")
+	if m.err != nil {
+		return m.err
+	}
+	if accessible.On() {
+		paths, err := m.ask()
+		if err != nil {
+			return err
+		}
+		return o.print(paths)
 	}
 
 	ctx, cancel := timeout.Context(o.Timeout)
 	defer cancel()
 
 	tm, err := tea.NewProgram(
-		&m,
+		m,
 		tea.WithOutput(os.Stderr),
 		tea.WithContext(ctx),
 	).Run()
@@ -67,10 +107,26 @@ func (o Options) Run() error {
 		return fmt.Errorf("unable to pick selection: %w", err)
 	}
 	m = tm.(model)
-	if m.selectedPath == "" {
+	if !m.submitted || len(m.selected) == 0 {
 		return errors.New("no file selected")
 	}
 
-	fmt.Println(m.selectedPath)
+	return o.print(m.selected)
+}
+
+// print writes the paths in the output format.
+func (o Options) print(paths []string) error {
+	switch o.OutputFormat {
+	case "json":
+		out, err := json.Marshal(paths)
+		if err != nil {
+			return fmt.Errorf("unable to encode selection: %w", err)
+		}
+		fmt.Println(string(out))
+	case "nul":
+		fmt.Print(strings.Join(paths, "\x00") + "\x00")
+	default:
+		fmt.Println(strings.Join(paths, "\n"))
+	}
 	return nil
 }
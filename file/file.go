@@ -13,28 +13,60 @@
 package file
 
 import (
+	"fmt"
+	"math"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
 	"github.com/charmbracelet/bubbles/filepicker"
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/gum/internal/bindings"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/dustin/go-humanize"
 )
 
-type keymap filepicker.KeyMap
-
-var keyQuit = key.NewBinding(
-	key.WithKeys("esc", "q"),
-	key.WithHelp("esc", "close"),
-)
+type keymap struct {
+	filepicker.KeyMap
+	Toggle  key.Binding
+	Jump    key.Binding
+	Preview key.Binding
+	Quit    key.Binding
+	Abort   key.Binding
+}
 
-var keyAbort = key.NewBinding(
-	key.WithKeys("ctrl+c"),
-	key.WithHelp("ctrl+c", "abort"),
-)
+// autoHeightMargin is the number of lines left around the list when its
+// height follows the terminal.
+const autoHeightMargin = 5
 
 func defaultKeymap() keymap {
-	km := filepicker.DefaultKeyMap()
-	return keymap(km)
+	return keymap{
+		KeyMap: filepicker.DefaultKeyMap(),
+		Toggle: key.NewBinding(
+			key.WithKeys("tab", " "),
+			key.WithHelp("tab", "toggle"),
+			key.WithDisabled(),
+		),
+		Jump: key.NewBinding(
+			key.WithKeys("/"),
+			key.WithHelp("/", "jump"),
+		),
+		Preview: key.NewBinding(
+			key.WithKeys("p"),
+			key.WithHelp("p", "preview"),
+		),
+		Quit: key.NewBinding(
+			key.WithKeys("esc", "q"),
+			key.WithHelp("esc", "close"),
+		),
+		Abort: key.NewBinding(
+			key.WithKeys("ctrl+c"),
+			key.WithHelp("ctrl+c", "abort"),
+		),
+	}
 }
 
 // FullHelp implements help.KeyMap.
@@ -43,52 +75,235 @@ func (k keymap) FullHelp() [][]key.Binding { return nil }
 // ShortHelp implements help.KeyMap.
 func (k keymap) ShortHelp() []key.Binding {
 	return []key.Binding{
-		key.NewBinding(
-			key.WithKeys("up", "down"),
-			key.WithHelp("↓↑", "navigate"),
-		),
-		keyQuit,
+		bindings.Help("navigate", k.Down, k.Up),
+		k.Quit,
+		k.Toggle,
+		k.Jump,
+		k.Preview,
 		k.Select,
 	}
 }
 
 type model struct {
-	header       string
-	headerStyle  lipgloss.Style
-	filepicker   filepicker.Model
-	selectedPath string
-	quitting     bool
-	showHelp     bool
-	help         help.Model
-	keymap       keymap
+	header      string
+	headerStyle lipgloss.Style
+	quitting    bool
+	showHelp    bool
+	help        help.Model
+	keymap      keymap
+
+	dir        string
+	entries    []entry
+	err        error
+	cursor     int
+	offset     int
+	height     int
+	autoHeight bool
+
+	showHidden      bool
+	showPermissions bool
+	showSize        bool
+	fileAllowed     bool
+	dirAllowed      bool
+	allowedTypes    []string
+
+	cursorChar       string
+	selectedPrefix   string
+	unselectedPrefix string
+	styles           filepicker.Styles
+
+	// selected holds the picked paths in the order they were picked.
+	selected  []string
+	limit     int
+	submitted bool
+
+	// jumping is set while typing a name to jump to.
+	jumping bool
+	query   string
+
+	// preview holds the rendered preview of previewKey, which identifies the
+	// previewed entry and the size of the pane.
+	showPreview    bool
+	previewMaxSize int64
+	previewStyle   lipgloss.Style
+	codeStyle      string
+	preview        string
+	previewKey     string
+	width          int
 }
 
-func (m model) Init() tea.Cmd { return m.filepicker.Init() }
+func (m model) Init() tea.Cmd { return nil }
+
+// open reads the directory and moves the cursor to the entry of the given
+// name, if any.
+func (m model) open(dir, name string) model {
+	entries, err := readDir(dir, m.showHidden)
+	if err != nil {
+		m.err = err
+		return m
+	}
+	m.dir, m.entries, m.err = dir, entries, nil
+	m.cursor, m.offset = 0, 0
+	for i, e := range entries {
+		if e.name == name {
+			m.moveTo(i)
+			break
+		}
+	}
+	return m
+}
+
+// moveTo moves the cursor, scrolling to keep it visible.
+func (m *model) moveTo(i int) {
+	m.cursor = max(0, min(i, len(m.entries)-1))
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	}
+	if m.height > 0 && m.cursor >= m.offset+m.height {
+		m.offset = m.cursor - m.height + 1
+	}
+}
+
+func (m model) current() (entry, bool) {
+	if len(m.entries) == 0 {
+		return entry{}, false
+	}
+	return m.entries[m.cursor], true
+}
+
+// selectable reports whether the entry can be picked.
+func (m model) selectable(e entry) bool {
+	if e.isDir {
+		return m.dirAllowed
+	}
+	return m.fileAllowed && allowed(e.name, m.allowedTypes)
+}
+
+func (m model) isSelected(path string) int {
+	for i, p := range m.selected {
+		if p == path {
+			return i
+		}
+	}
+	return -1
+}
+
+// toggle picks the entry under the cursor, or unpicks it.
+func (m model) toggle() model {
+	e, ok := m.current()
+	if !ok || !m.selectable(e) {
+		return m
+	}
+	path := filepath.Join(m.dir, e.name)
+	if i := m.isSelected(path); i >= 0 {
+		m.selected = append(m.selected[:i], m.selected[i+1:]...)
+	} else if len(m.selected) < m.limit {
+		m.selected = append(m.selected, path)
+	}
+	return m
+}
 
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	m, cmd := m.update(msg)
+	return m.refreshPreview(), cmd
+}
+
+func (m model) update(msg tea.Msg) (model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
-		if m.showHelp {
-			m.filepicker.Height -= lipgloss.Height(m.helpView())
+		m.width = msg.Width
+		if m.autoHeight {
+			m.height = msg.Height - autoHeightMargin
+			if m.showHelp {
+				m.height -= lipgloss.Height(m.helpView())
+			}
+			m.moveTo(m.cursor)
 		}
 	case tea.KeyMsg:
-		switch {
-		case key.Matches(msg, keyAbort):
+		if key.Matches(msg, m.keymap.Abort) {
 			m.quitting = true
 			return m, tea.Interrupt
-		case key.Matches(msg, keyQuit):
-			m.quitting = true
-			return m, tea.Quit
 		}
+		if m.jumping {
+			return m.updateJump(msg), nil
+		}
+		return m.updateKey(msg)
+	}
+	return m, nil
+}
+
+// updateJump edits the name to jump to.
+func (m model) updateJump(msg tea.KeyMsg) model {
+	switch msg.Type {
+	case tea.KeyEsc, tea.KeyEnter:
+		m.jumping = false
+		m.query = ""
+		return m
+	case tea.KeyBackspace:
+		if m.query == "" {
+			m.jumping = false
+			return m
+		}
+		_, size := utf8.DecodeLastRuneInString(m.query)
+		m.query = m.query[:len(m.query)-size]
+	case tea.KeyRunes, tea.KeySpace:
+		m.query += string(msg.Runes)
+	default:
+		return m
 	}
-	var cmd tea.Cmd
-	m.filepicker, cmd = m.filepicker.Update(msg)
-	if didSelect, path := m.filepicker.DidSelectFile(msg); didSelect {
-		m.selectedPath = path
+	if i := jump(m.entries, m.query); i >= 0 {
+		m.moveTo(i)
+	}
+	return m
+}
+
+func (m model) updateKey(msg tea.KeyMsg) (model, tea.Cmd) {
+	km := m.keymap
+	switch {
+	case key.Matches(msg, km.Quit):
 		m.quitting = true
 		return m, tea.Quit
+	case key.Matches(msg, km.Jump):
+		m.jumping = true
+	case key.Matches(msg, km.Preview):
+		m.showPreview = !m.showPreview
+	case key.Matches(msg, km.Toggle):
+		m = m.toggle()
+		m.moveTo(m.cursor + 1)
+	case key.Matches(msg, km.GoToTop):
+		m.moveTo(0)
+	case key.Matches(msg, km.GoToLast):
+		m.moveTo(len(m.entries) - 1)
+	case key.Matches(msg, km.Down):
+		m.moveTo(m.cursor + 1)
+	case key.Matches(msg, km.Up):
+		m.moveTo(m.cursor - 1)
+	case key.Matches(msg, km.PageDown):
+		m.moveTo(m.cursor + max(1, m.height))
+	case key.Matches(msg, km.PageUp):
+		m.moveTo(m.cursor - max(1, m.height))
+	case key.Matches(msg, km.Back):
+		return m.open(filepath.Dir(m.dir), filepath.Base(m.dir)), nil
+	case key.Matches(msg, km.Open), key.Matches(msg, km.Select):
+		e, ok := m.current()
+		if !ok {
+			break
+		}
+		if key.Matches(msg, km.Select) && (m.selectable(e) || len(m.selected) > 0) {
+			// Enter picks the entry under the cursor, unless some entries
+			// were already picked.
+			if len(m.selected) == 0 {
+				m.selected = []string{filepath.Join(m.dir, e.name)}
+			}
+			m.submitted = true
+			m.quitting = true
+			return m, tea.Quit
+		}
+		if e.isDir {
+			return m.open(filepath.Join(m.dir, e.name), ""), nil
+		}
 	}
-	return m, cmd
+	return m, nil
 }
 
 func (m model) View() string {
@@ -99,13 +314,137 @@ func (m model) View() string {
 	if m.header != "" {
 		parts = append(parts, m.headerStyle.Render(m.header))
 	}
-	parts = append(parts, m.filepicker.View())
+	list := m.listView()
+	if m.showPreview && m.preview != "" {
+		pane := m.previewStyle.
+			Width(m.previewWidth() + m.previewStyle.GetHorizontalPadding()).
+			Height(max(m.height, lipgloss.Height(list)) - m.previewStyle.GetVerticalBorderSize()).
+			Render(m.preview)
+		list = lipgloss.JoinHorizontal(lipgloss.Top, list, " ", pane)
+	}
+	parts = append(parts, list)
+	if status := m.statusView(); status != "" {
+		parts = append(parts, status)
+	}
 	if m.showHelp {
 		parts = append(parts, m.helpView())
 	}
 	return lipgloss.JoinVertical(lipgloss.Left, parts...)
 }
 
+// statusView shows the name being jumped to, or the number of picked files.
+func (m model) statusView() string {
+	switch {
+	case m.err != nil:
+		return m.styles.DisabledFile.Render(m.err.Error())
+	case m.jumping:
+		return "/" + m.query
+	case m.limit > 1:
+		count := strconv.Itoa(len(m.selected))
+		if m.limit < math.MaxInt {
+			count += "/" + strconv.Itoa(m.limit)
+		}
+		return m.styles.DisabledFile.Render(count + " selected")
+	}
+	return ""
+}
+
+func (m model) listView() string {
+	if len(m.entries) == 0 {
+		return m.styles.EmptyDirectory.Height(m.height).MaxHeight(m.height).String()
+	}
+
+	var s strings.Builder
+	end := len(m.entries)
+	if m.height > 0 {
+		end = min(end, m.offset+m.height)
+	}
+	for i := m.offset; i < end; i++ {
+		e := m.entries[i]
+		path := filepath.Join(m.dir, e.name)
+
+		prefix := ""
+		if m.limit > 1 {
+			prefix = m.unselectedPrefix
+			if m.isSelected(path) >= 0 {
+				prefix = m.selectedPrefix
+			}
+		}
+		size := strings.Replace(humanize.Bytes(uint64(e.info.Size())), " ", "", 1) //nolint:gosec
+		name := e.name
+		if e.link != "" {
+			name += " → " + e.link
+		}
+		disabled := !e.isDir && !m.selectable(e)
+
+		if i == m.cursor {
+			line := ""
+			if m.showPermissions {
+				line += " " + e.info.Mode().String()
+			}
+			if m.showSize {
+				line += fmt.Sprintf("%"+strconv.Itoa(m.styles.FileSize.GetWidth())+"s", size)
+			}
+			line += " " + prefix + name
+			if disabled {
+				s.WriteString(m.styles.DisabledSelected.Render(m.cursorChar) + m.styles.DisabledSelected.Render(line))
+			} else {
+				s.WriteString(m.styles.Cursor.Render(m.cursorChar) + m.styles.Selected.Render(line))
+			}
+			s.WriteRune('\n')
+			continue
+		}
+
+		style := m.styles.File
+		switch {
+		case e.isDir:
+			style = m.styles.Directory
+		case e.link != "":
+			style = m.styles.Symlink
+		case disabled:
+			style = m.styles.DisabledFile
+		}
+		s.WriteString(m.styles.Cursor.Render(strings.Repeat(" ", lipgloss.Width(m.cursorChar))))
+		if m.showPermissions {
+			s.WriteString(" " + m.styles.Permission.Render(e.info.Mode().String()))
+		}
+		if m.showSize {
+			s.WriteString(m.styles.FileSize.Render(size))
+		}
+		s.WriteString(" " + prefix + style.Render(name))
+		s.WriteRune('\n')
+	}
+
+	for i := end - m.offset; i < m.height; i++ {
+		s.WriteRune('\n')
+	}
+	return strings.TrimSuffix(s.String(), "\n")
+}
+
+// previewWidth is the width left for the content of the preview pane.
+func (m model) previewWidth() int {
+	return m.width - lipgloss.Width(m.listView()) - 1 - m.previewStyle.GetHorizontalFrameSize()
+}
+
+// refreshPreview renders the preview of the entry under the cursor, if it
+// changed.
+func (m model) refreshPreview() model {
+	e, ok := m.current()
+	width := m.previewWidth()
+	height := max(m.height, 1) - m.previewStyle.GetVerticalFrameSize()
+	if !m.showPreview || !ok || width < 10 || height < 1 {
+		m.preview, m.previewKey = "", ""
+		return m
+	}
+	path := filepath.Join(m.dir, e.name)
+	key := fmt.Sprintf("%s:%dx%d", path, width, height)
+	if key != m.previewKey {
+		m.preview = preview(path, e, width, height, m.previewMaxSize, m.codeStyle)
+		m.previewKey = key
+	}
+	return m
+}
+
 func (m model) helpView() string {
 	return m.help.View(m.keymap)
 }
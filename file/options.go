@@ -11,16 +11,28 @@ type Options struct {
 	// Path is the path to the folder / directory to begin traversing.
 	Path string `arg:"" optional:"" name:"path" help:"The path to the folder to begin traversing" env:"GUM_FILE_PATH"`
 	// Cursor is the character to display in front of the current selected items.
-	Cursor      string        `short:"c" help:"The cursor character" default:">" env:"GUM_FILE_CURSOR"`
-	All         bool          `short:"a" help:"Show hidden and 'dot' files" default:"false" env:"GUM_FILE_ALL"`
-	Permissions bool          `short:"p" help:"Show file permissions" default:"true" negatable:"" env:"GUM_FILE_PERMISSION"`
-	Size        bool          `short:"s" help:"Show file size" default:"true" negatable:"" env:"GUM_FILE_SIZE"`
-	File        bool          `help:"Allow files selection" default:"true" env:"GUM_FILE_FILE"`
-	Directory   bool          `help:"Allow directories selection" default:"false" env:"GUM_FILE_DIRECTORY"`
-	ShowHelp    bool          `help:"Show help key binds" negatable:"" default:"true" env:"GUM_FILE_SHOW_HELP"`
-	Timeout     time.Duration `help:"Timeout until command aborts without a selection" default:"0s" env:"GUM_FILE_TIMEOUT"`
-	Header      string        `help:"Header value" default:"" env:"GUM_FILE_HEADER"`
-	Height      int           `help:"Maximum number of files to display" default:"10" env:"GUM_FILE_HEIGHT"`
+	Cursor      string            `short:"c" help:"The cursor character" default:">" env:"GUM_FILE_CURSOR"`
+	All         bool              `short:"a" help:"Show hidden and 'dot' files" default:"false" env:"GUM_FILE_ALL"`
+	Permissions bool              `short:"p" help:"Show file permissions" default:"true" negatable:"" env:"GUM_FILE_PERMISSION"`
+	Size        bool              `short:"s" help:"Show file size" default:"true" negatable:"" env:"GUM_FILE_SIZE"`
+	File        bool              `help:"Allow files selection" default:"true" env:"GUM_FILE_FILE"`
+	Directory   bool              `help:"Allow directories selection" default:"false" env:"GUM_FILE_DIRECTORY"`
+	ShowHelp    bool              `help:"Show help key binds" negatable:"" default:"true" env:"GUM_FILE_SHOW_HELP"`
+	Keymap      map[string]string `help:"Remap key bindings, as action=keys (e.g. down=ctrl+j,down)" placeholder:"action=keys;..." env:"GUM_FILE_KEYMAP"`
+	Timeout     time.Duration     `help:"Timeout until command aborts without a selection" default:"0s" env:"GUM_FILE_TIMEOUT"`
+	Header      string            `help:"Header value" default:"" env:"GUM_FILE_HEADER"`
+	Height      int               `help:"Maximum number of files to display" default:"10" env:"GUM_FILE_HEIGHT"`
+
+	Limit            int      `help:"Maximum number of files to pick" default:"1" group:"Selection"`
+	NoLimit          bool     `help:"Pick unlimited number of files" group:"Selection"`
+	AllowedTypes     []string `help:"Allowed file types, as extensions (.go) or globs (*_test.go)" default:"" env:"GUM_FILE_ALLOWED_TYPES" group:"Selection"`
+	OutputFormat     string   `help:"Output format of the picked paths" enum:"newline,nul,json" default:"newline" env:"GUM_FILE_OUTPUT_FORMAT" group:"Selection"`
+	SelectedPrefix   string   `help:"Prefix to show on picked files (hidden if limit is 1)" default:"✓ " env:"GUM_FILE_SELECTED_PREFIX" group:"Selection"`
+	UnselectedPrefix string   `help:"Prefix to show on other files (hidden if limit is 1)" default:"  " env:"GUM_FILE_UNSELECTED_PREFIX" group:"Selection"`
+
+	Preview        bool         `help:"Show a preview of the file under the cursor (toggle with p)" default:"false" negatable:"" env:"GUM_FILE_PREVIEW" group:"Preview"`
+	PreviewMaxSize int64        `help:"Maximum number of bytes of a file to read for its preview" default:"65536" env:"GUM_FILE_PREVIEW_MAX_SIZE" group:"Preview"`
+	PreviewStyle   style.Styles `embed:"" prefix:"preview." set:"defaultBorder=rounded" set:"defaultBorderForeground=240" set:"defaultPadding=0 1" envprefix:"GUM_FILE_PREVIEW_"` //nolint:staticcheck
 
 	CursorStyle      style.Styles `embed:"" prefix:"cursor." help:"The cursor style" set:"defaultForeground=212" envprefix:"GUM_FILE_CURSOR_"`
 	SymlinkStyle     style.Styles `embed:"" prefix:"symlink." help:"The style to use for symlinks" set:"defaultForeground=36" envprefix:"GUM_FILE_SYMLINK_"`
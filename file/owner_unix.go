@@ -0,0 +1,23 @@
+//go:build !windows
+
+package file
+
+import (
+	"os"
+	"os/user"
+	"strconv"
+	"syscall"
+)
+
+// owner returns the name of the owner of the file.
+func owner(info os.FileInfo) string {
+	st, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return ""
+	}
+	uid := strconv.FormatUint(uint64(st.Uid), 10)
+	if u, err := user.LookupId(uid); err == nil {
+		return u.Username
+	}
+	return uid
+}
@@ -0,0 +1,9 @@
+//go:build windows
+
+package file
+
+import "os"
+
+// owner returns the name of the owner of the file, which is not available on
+// Windows.
+func owner(os.FileInfo) string { return "" }
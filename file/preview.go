@@ -0,0 +1,119 @@
+package file
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/gum/format"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/dustin/go-humanize"
+)
+
+// preview renders the beginning of text files, the listing of directories and
+// the metadata of other files, reading at most maxSize bytes of files.
+func preview(path string, e entry, width, height int, maxSize int64, style string) string {
+	var content string
+	switch {
+	case e.isDir:
+		content = previewDir(path, height)
+	default:
+		text, ok := readText(path, maxSize)
+		if !ok {
+			content = metadata(e)
+			break
+		}
+		if text == "" {
+			content = "(empty)"
+			break
+		}
+		lines := strings.SplitN(text, "\n", height+1)
+		text = strings.Join(lines[:min(len(lines), height)], "\n")
+		language := strings.TrimPrefix(filepath.Ext(e.name), ".")
+		if rendered, err := format.Code(text, language, style); err == nil {
+			content = trimBlankLines(rendered)
+		} else {
+			content = text
+		}
+	}
+
+	lines := strings.Split(content, "\n")
+	lines = lines[:min(len(lines), height)]
+	for i, line := range lines {
+		lines[i] = ansi.Truncate(line, width, "…")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// readText reads the beginning of the file, reporting whether it is text.
+func readText(path string, maxSize int64) (string, bool) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", false
+	}
+	defer f.Close() //nolint:errcheck
+
+	bts, err := io.ReadAll(io.LimitReader(f, maxSize))
+	if err != nil || bytes.IndexByte(bts, 0) >= 0 {
+		return "", false
+	}
+	// The limit may cut a multi-byte character.
+	for i := 0; i < utf8.UTFMax && !utf8.Valid(bts); i++ {
+		bts = bts[:len(bts)-1]
+	}
+	if !utf8.Valid(bts) {
+		return "", false
+	}
+	return string(bts), true
+}
+
+func previewDir(path string, height int) string {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return err.Error()
+	}
+	if len(entries) == 0 {
+		return "(empty)"
+	}
+	var names []string
+	for _, e := range entries[:min(len(entries), height)] {
+		name := e.Name()
+		if e.IsDir() {
+			name += "/"
+		}
+		names = append(names, name)
+	}
+	return strings.Join(names, "\n")
+}
+
+func metadata(e entry) string {
+	lines := []string{
+		fmt.Sprintf("Size:     %s", humanize.Bytes(uint64(e.info.Size()))), //nolint:gosec
+		fmt.Sprintf("Modified: %s", e.info.ModTime().Format("2006-01-02 15:04:05")),
+		fmt.Sprintf("Mode:     %s", e.info.Mode()),
+	}
+	if owner := owner(e.info); owner != "" {
+		lines = append(lines, fmt.Sprintf("Owner:    %s", owner))
+	}
+	if e.link != "" {
+		lines = append(lines, fmt.Sprintf("Target:   %s", e.link))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// trimBlankLines removes the blank lines around the rendered code.
+func trimBlankLines(s string) string {
+	lines := strings.Split(s, "\n")
+	blank := func(line string) bool { return strings.TrimSpace(ansi.Strip(line)) == "" }
+	for len(lines) > 0 && blank(lines[0]) {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && blank(lines[len(lines)-1]) {
+		lines = lines[:len(lines)-1]
+	}
+	return strings.Join(lines, "\n")
+}
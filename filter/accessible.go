@@ -0,0 +1,114 @@
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/gum/internal/accessible"
+)
+
+// accessibleHeight is how many matches are listed when no height is given.
+const accessibleHeight = 20
+
+// ask lists the matches numbered, and reads either the numbers of the
+// picked ones or a new query, which starts with a slash if it is a number.
+// Nothing typed picks the selected choices, or the one under the cursor.
+func (o Options) ask(m model) ([]ref, error) {
+	if m.limit == 1 {
+		// The selected choice is the one under the cursor.
+		clear(m.selected)
+	}
+	if m.header != "" {
+		accessible.Println(m.header)
+	}
+	height := o.Height
+	if height <= 0 {
+		height = accessibleHeight
+	}
+	query := m.textinput.Value()
+	for {
+		shown := m.matches[:min(height, len(m.matches))]
+		for i, match := range shown {
+			line := accessible.Item(i+1, match.Str)
+			if _, ok := m.selected[refOf(match)]; ok && m.limit > 1 {
+				line += " (selected)"
+			}
+			accessible.Println(line)
+		}
+		switch more := len(m.matches) - len(shown); {
+		case len(m.matches) == 0:
+			accessible.Println(fmt.Sprintf("Nothing matches %q.", query))
+		case more > 0:
+			accessible.Println(fmt.Sprintf("And %d more, type to narrow them down.", more))
+		}
+
+		var prompt string
+		switch {
+		case len(shown) == 0:
+			prompt = "Type to filter: "
+		case m.limit == 1:
+			prompt = fmt.Sprintf("Type to filter, or choose from 1 to %d [%d]: ", len(shown), m.cursor+1)
+		default:
+			prompt = fmt.Sprintf("Type to filter, or choose up to %d from 1 to %d, separated by spaces: ",
+				min(m.limit, len(shown)), len(shown))
+		}
+		line, err := accessible.ReadLine(prompt)
+		if err != nil {
+			return nil, err
+		}
+		line = strings.TrimSpace(line)
+
+		switch {
+		case line == "":
+			if picked := o.defaults(m, query); picked != nil {
+				return picked, nil
+			}
+			continue
+		case isNumber(line):
+			indices, err := accessible.ParseNumbers(line, len(shown), m.limit)
+			if err != nil {
+				accessible.Println(err.Error())
+				continue
+			}
+			picked := make([]ref, len(indices))
+			for i, index := range indices {
+				picked[i] = refOf(shown[index])
+			}
+			return picked, nil
+		}
+
+		query = strings.TrimPrefix(line, "/")
+		m.cursor = 0
+		if query == "" {
+			m.matches = m.boost(matchAll(m.choices))
+		} else {
+			m.matches = m.find(query, false)
+		}
+	}
+}
+
+// defaults returns the selected choices, else the one under the cursor,
+// else the query unless matches are strict.
+func (o Options) defaults(m model, query string) []ref {
+	switch {
+	case len(m.selected) > 0:
+		return m.selection()
+	case m.cursor < len(m.matches):
+		return []ref{refOf(m.matches[m.cursor])}
+	case !o.Strict && query != "":
+		return []ref{{index: -1, text: query}}
+	}
+	return nil
+}
+
+// isNumber reports whether the line starts with a number, and so picks
+// rather than filters.
+func isNumber(line string) bool {
+	fields := strings.FieldsFunc(line, func(r rune) bool { return r == ',' || r == ' ' || r == '\t' })
+	if len(fields) == 0 {
+		return false
+	}
+	_, err := strconv.Atoi(fields[0])
+	return err == nil
+}
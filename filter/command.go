@@ -1,27 +1,44 @@
 package filter
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"math"
 	"os"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/gum/internal/accessible"
+	"github.com/charmbracelet/gum/internal/answer"
+	"github.com/charmbracelet/gum/internal/bindings"
 	"github.com/charmbracelet/gum/internal/files"
+	"github.com/charmbracelet/gum/internal/history"
+	"github.com/charmbracelet/gum/internal/mouse"
 	"github.com/charmbracelet/gum/internal/stdin"
 	"github.com/charmbracelet/gum/internal/timeout"
 	"github.com/charmbracelet/gum/internal/tty"
-	"github.com/charmbracelet/x/ansi"
-	"github.com/sahilm/fuzzy"
 )
 
 // Run provides a shell script interface for filtering through options, powered
 // by the textinput bubble.
 func (o Options) Run() error {
+	out, err := o.RunBingoo()
+	if err != nil || out == nil {
+		return err
+	}
+	tty.Println(strings.Join(out, o.OutputDelimiter))
+	return nil
+}
+
+// RunBingoo filters through the options, and returns the outputs of the
+// picked ones.
+func (o Options) RunBingoo() ([]string, error) {
 	i := textinput.New()
 	i.Focus()
 
@@ -36,13 +53,30 @@ func (o Options) Run() error {
 	if len(o.Options) == 0 {
 		if input, _ := stdin.Read(stdin.StripANSI(o.StripANSI)); input != "" {
 			o.Options = strings.Split(input, o.InputDelimiter)
-		} else {
-			o.Options = files.List()
 		}
 	}
 
+	// Listed files are not part of the prompt, they may change.
+	prompt := answer.Prompt{Command: "filter", ID: answer.Key(o.ID, o.Header), Options: o.Options}
+	answers, answered, err := answer.Lookup(prompt)
+	if err != nil {
+		return nil, err
+	}
+	answered = answered || answer.Yes()
+
+	// Without options, the files of the current directory are listed, and
+	// streamed in while filtering unless all of them are needed upfront.
+	stream := false
 	if len(o.Options) == 0 {
-		return errors.New("no options provided, see `gum filter --help`")
+		if o.SelectIfOne || len(o.Selected) > 0 || answered || accessible.On() {
+			o.Options = files.List(o.files())
+		} else {
+			stream = true
+		}
+	}
+
+	if len(o.Options) == 0 && !stream {
+		return nil, errors.New("no options provided, see `gum filter --help`")
 	}
 
 	ctx, cancel := timeout.Context(o.Timeout)
@@ -57,37 +91,36 @@ func (o Options) Run() error {
 		options = append(options, tea.WithAltScreen())
 	}
 
-	var matches []fuzzy.Match
 	if o.Value != "" {
 		i.SetValue(o.Value)
 	}
 
-	choices := map[string]string{}
-	filteringChoices := []string{}
-	for _, opt := range o.Options {
-		s := ansi.Strip(opt)
-		choices[s] = opt
-		filteringChoices = append(filteringChoices, s)
+	nth, err := parseFields(o.Nth)
+	if err != nil {
+		return nil, err
 	}
-	switch {
-	case o.Value != "" && o.Fuzzy:
-		matches = fuzzy.Find(o.Value, filteringChoices)
-	case o.Value != "" && !o.Fuzzy:
-		matches = exactMatches(o.Value, filteringChoices)
-	default:
-		matches = matchAll(filteringChoices)
+	withNth, err := parseFields(o.WithNth)
+	if err != nil {
+		return nil, err
+	}
+	acceptNth, err := parseFields(o.AcceptNth)
+	if err != nil {
+		return nil, err
 	}
 
-	if o.NoLimit {
-		o.Limit = len(o.Options)
+	// --no-fuzzy predates the algorithm selection.
+	if !o.Fuzzy && o.Algorithm == "fuzzy" {
+		o.Algorithm = "substring"
 	}
 
-	if o.SelectIfOne && len(matches) == 1 {
-		tty.Println(matches[0].Str)
-		return nil
+	if o.NoLimit {
+		o.Limit = math.MaxInt
 	}
 
 	km := defaultKeymap()
+	if err := bindings.Remap("filter", o.Keymap, &km); err != nil {
+		return nil, err
+	}
 	if o.NoLimit || o.Limit > 1 {
 		km.Toggle.SetEnabled(true)
 		km.ToggleAndPrevious.SetEnabled(true)
@@ -95,11 +128,18 @@ func (o Options) Run() error {
 		km.ToggleAll.SetEnabled(true)
 	}
 
+	frecency, err := history.Scores(o.HistoryKey)
+	if err != nil {
+		return nil, err
+	}
+
 	m := model{
-		choices:               choices,
-		filteringChoices:      filteringChoices,
+		fields:                fields{delimiter: o.Delimiter},
+		nthFields:             nth,
+		withNth:               withNth,
+		acceptNth:             acceptNth,
+		frecency:              frecency,
 		indicator:             o.Indicator,
-		matches:               matches,
 		header:                o.Header,
 		textinput:             i,
 		viewport:              &v,
@@ -113,10 +153,11 @@ func (o Options) Run() error {
 		textStyle:             o.TextStyle.ToLipgloss(),
 		cursorTextStyle:       o.CursorTextStyle.ToLipgloss(),
 		height:                o.Height,
-		selected:              make(map[string]struct{}),
+		selected:              make(map[ref]struct{}),
 		limit:                 o.Limit,
 		reverse:               o.Reverse,
-		fuzzy:                 o.Fuzzy,
+		algorithm:             o.Algorithm,
+		tiebreak:              o.Tiebreak,
 		sort:                  o.Sort && o.FuzzySort,
 		strict:                o.Strict,
 		showHelp:              o.ShowHelp,
@@ -124,6 +165,18 @@ func (o Options) Run() error {
 		help:                  help.New(),
 	}
 
+	m.add(o.Options)
+	if o.Value != "" {
+		m.matches = m.find(o.Value, false)
+	} else {
+		m.matches = m.boost(matchAll(m.choices))
+	}
+	matches := m.matches
+
+	if o.SelectIfOne && len(matches) == 1 {
+		return o.pick(m, prompt, refOf(matches[0]))
+	}
+
 	isSelectAll := len(o.Selected) == 1 && o.Selected[0] == "*"
 	currentSelected := 0
 	if len(o.Selected) > 0 {
@@ -133,40 +186,139 @@ func (o Options) Run() error {
 			}
 			if o.Limit == 1 {
 				m.cursor = i
-				m.selected[option.Str] = struct{}{}
+				m.selected[refOf(option)] = struct{}{}
 			} else {
 				currentSelected++
-				m.selected[option.Str] = struct{}{}
+				m.selected[refOf(option)] = struct{}{}
 			}
 		}
 	}
 
-	tm, err := tea.NewProgram(m, options...).Run()
+	if answered {
+		return o.answer(m, prompt, answers)
+	}
+	if accessible.On() {
+		picked, err := o.ask(m)
+		if err != nil {
+			return nil, err
+		}
+		return o.pick(m, prompt, picked...)
+	}
+
+	if o.Mouse {
+		options = append(options, tea.WithMouseCellMotion())
+		if o.Height == 0 {
+			m.mouse = mouse.Fullscreen()
+		} else {
+			m.mouse = mouse.New()
+		}
+	}
+	p := tea.NewProgram(m, options...)
+	if stream {
+		walkCtx, cancelWalk := context.WithCancel(ctx)
+		defer cancelWalk()
+		go streamFiles(walkCtx, p, o.files())
+	}
+	tm, err := p.Run()
 	if err != nil {
-		return fmt.Errorf("unable to run filter: %w", err)
+		return nil, fmt.Errorf("unable to run filter: %w", err)
 	}
 
 	m = tm.(model)
 	if !m.submitted {
-		return errors.New("nothing selected")
+		return nil, errors.New("nothing selected")
 	}
 
 	// allSelections contains values only if limit is greater
 	// than 1 or if flag --no-limit is passed, hence there is
 	// no need to further checks
 	if len(m.selected) > 0 {
-		o.checkSelected(m)
+		return o.pick(m, prompt, m.selection()...)
 	} else if len(m.matches) > m.cursor && m.cursor >= 0 {
-		tty.Println(m.matches[m.cursor].Str)
+		return o.pick(m, prompt, refOf(m.matches[m.cursor]))
 	}
 
-	return nil
+	return nil, nil
 }
 
-func (o Options) checkSelected(m model) {
-	out := []string{}
-	for k := range m.selected {
-		out = append(out, k)
+// pick records the picked choices, and returns their outputs.
+func (o Options) pick(m model, prompt answer.Prompt, picked ...ref) ([]string, error) {
+	labels := make([]string, len(picked))
+	out := make([]string, len(picked))
+	for i, r := range picked {
+		labels[i] = r.text
+		out[i] = m.output(r)
 	}
-	tty.Println(strings.Join(out, o.OutputDelimiter))
+	if err := history.Record(o.HistoryKey, labels...); err != nil {
+		return nil, err
+	}
+	prompt.Answer = labels
+	if err := answer.Record(prompt); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// answer picks the answers from the recording or the answers file, or
+// without answers (when assuming yes) the selected options, else the best
+// match of the value.
+func (o Options) answer(m model, prompt answer.Prompt, answers []string) ([]string, error) {
+	// The answers were not picked by the user.
+	o.HistoryKey = ""
+	if answers == nil {
+		switch {
+		case len(m.selected) > 0:
+			return o.pick(m, prompt, m.selection()...)
+		case len(m.matches) > 0:
+			return o.pick(m, prompt, refOf(m.matches[m.cursor]))
+		case !o.Strict && o.Value != "":
+			answers = []string{o.Value}
+		default:
+			return nil, errors.New("nothing matched")
+		}
+	}
+
+	switch {
+	case len(answers) == 0:
+		return nil, fmt.Errorf("no answer to %q", prompt.ID)
+	case len(answers) > o.Limit:
+		return nil, fmt.Errorf("expected at most %d answers to %q, got %d", o.Limit, prompt.ID, len(answers))
+	}
+	picked := make([]ref, len(answers))
+	for i, a := range answers {
+		// Answers name the first choice showing them.
+		index := slices.IndexFunc(m.choices, func(c choice) bool { return c.display == a })
+		if index < 0 && o.Strict {
+			return nil, fmt.Errorf("%q is not an option of %q", a, prompt.ID)
+		}
+		picked[i] = ref{index: index, text: a}
+	}
+	return o.pick(m, prompt, picked...)
+}
+
+// files returns the options of the file listing.
+func (o Options) files() files.Options {
+	return files.Options{
+		Hidden:         o.Hidden,
+		FollowSymlinks: o.FollowSymlinks,
+		MaxDepth:       o.MaxDepth,
+		Type:           o.Type,
+	}
+}
+
+// streamFiles sends the files of the current directory to the program in
+// batches, so that filtering starts before the walk is done.
+func streamFiles(ctx context.Context, p *tea.Program, o files.Options) {
+	const interval = 50 * time.Millisecond
+	var batch []string
+	last := time.Now()
+	_ = files.Walk(ctx, ".", o, func(path string) {
+		batch = append(batch, path)
+		if time.Since(last) >= interval {
+			p.Send(choicesMsg(batch))
+			batch = nil
+			last = time.Now()
+		}
+	})
+	p.Send(choicesMsg(batch))
 }
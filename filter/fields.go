@@ -0,0 +1,155 @@
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// fieldRange is a range of 1-based field indexes. Negative indexes count from
+// the last field, and 0 leaves the bound open.
+type fieldRange struct {
+	from, to int
+}
+
+// parseFields parses a comma-separated list of field indexes or ranges, such
+// as "1,3", "2..", "..-2" or "1..3".
+func parseFields(expr string) ([]fieldRange, error) {
+	if expr == "" {
+		return nil, nil
+	}
+	var ranges []fieldRange
+	for _, part := range strings.Split(expr, ",") {
+		from, to, isRange := strings.Cut(part, "..")
+		r, err := fieldIndexes(from, to, isRange)
+		if err != nil {
+			return nil, fmt.Errorf("invalid field range %q: %w", part, err)
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges, nil
+}
+
+func fieldIndexes(from, to string, isRange bool) (fieldRange, error) {
+	var r fieldRange
+	var err error
+	if from != "" {
+		if r.from, err = strconv.Atoi(from); err != nil {
+			return r, err
+		}
+	}
+	if !isRange {
+		if from == "" || r.from == 0 {
+			return r, fmt.Errorf("missing field index")
+		}
+		r.to = r.from
+		return r, nil
+	}
+	if to != "" {
+		if r.to, err = strconv.Atoi(to); err != nil {
+			return r, err
+		}
+	}
+	return r, nil
+}
+
+// fields splits lines into fields.
+type fields struct {
+	delimiter string
+}
+
+// spans returns the byte offsets of the fields of s. Without a delimiter,
+// fields are separated by whitespace.
+func (f fields) spans(s string) [][2]int {
+	var spans [][2]int
+	if f.delimiter != "" {
+		start := 0
+		for {
+			i := strings.Index(s[start:], f.delimiter)
+			if i < 0 {
+				return append(spans, [2]int{start, len(s)})
+			}
+			spans = append(spans, [2]int{start, start + i})
+			start += i + len(f.delimiter)
+		}
+	}
+	start := -1
+	for i, r := range s {
+		if unicode.IsSpace(r) {
+			if start >= 0 {
+				spans = append(spans, [2]int{start, i})
+				start = -1
+			}
+		} else if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		spans = append(spans, [2]int{start, len(s)})
+	}
+	return spans
+}
+
+// selected is the text of some fields of a line.
+type selected struct {
+	text string
+	// offsets maps each byte of text to the byte of the line it comes from,
+	// or -1 for the separators added between fields.
+	offsets []int
+}
+
+// translate maps indexes of the selected text to indexes of the line.
+func (s selected) translate(indexes []int) []int {
+	out := make([]int, 0, len(indexes))
+	for _, i := range indexes {
+		if i < len(s.offsets) && s.offsets[i] >= 0 {
+			out = append(out, s.offsets[i])
+		}
+	}
+	return out
+}
+
+// selectFields returns the fields of s in the given ranges, joined by the
+// delimiter (or a space if there is none).
+func (f fields) selectFields(s string, ranges []fieldRange) selected {
+	spans := f.spans(s)
+	sep := f.delimiter
+	if sep == "" {
+		sep = " "
+	}
+	index := func(i, unset int) int {
+		switch {
+		case i == 0:
+			return unset
+		case i < 0:
+			return len(spans) + i
+		default:
+			return i - 1
+		}
+	}
+
+	var sel selected
+	var b strings.Builder
+	written := false
+	for _, r := range ranges {
+		from := max(index(r.from, 0), 0)
+		to := min(index(r.to, len(spans)-1), len(spans)-1)
+		for i := from; i <= to; i++ {
+			if written {
+				b.WriteString(sep)
+				for range len(sep) {
+					sel.offsets = append(sel.offsets, -1)
+				}
+			}
+			field := s[spans[i][0]:spans[i][1]]
+			b.WriteString(field)
+			for j := range len(field) {
+				sel.offsets = append(sel.offsets, spans[i][0]+j)
+			}
+			written = true
+		}
+	}
+	sel.text = b.String()
+	return sel
+}
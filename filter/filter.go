@@ -11,6 +11,11 @@
 package filter
 
 import (
+	"cmp"
+	"maps"
+	"math"
+	"slices"
+	"sort"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/help"
@@ -18,7 +23,10 @@ import (
 	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/gum/internal/bindings"
+	"github.com/charmbracelet/gum/internal/mouse"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 	"github.com/rivo/uniseg"
 	"github.com/sahilm/fuzzy"
 )
@@ -110,10 +118,7 @@ func (k keymap) FullHelp() [][]key.Binding { return nil }
 // ShortHelp implements help.KeyMap.
 func (k keymap) ShortHelp() []key.Binding {
 	return []key.Binding{
-		key.NewBinding(
-			key.WithKeys("up", "down"),
-			key.WithHelp("↓↑", "navigate"),
-		),
+		bindings.Help("navigate", k.Down, k.Up),
 		k.FocusInSearch,
 		k.FocusOutSearch,
 		k.ToggleAndNext,
@@ -122,15 +127,46 @@ func (k keymap) ShortHelp() []key.Binding {
 	}
 }
 
+// choicesMsg adds streamed options.
+type choicesMsg []string
+
+// choice is a line of the input. Choices are kept by their position, as
+// several lines may show the same fields.
+type choice struct {
+	// display is the text shown and filtered, styled is shown with its
+	// styles, and output is printed when the choice is picked.
+	display string
+	styled  string
+	output  string
+	// nth are the fields filtered when filtering on some fields only.
+	nth *selected
+}
+
+// ref refers to a match: the choice at index, or the typed query with
+// index -1.
+type ref struct {
+	index int
+	text  string
+}
+
+// refOf returns the reference of the match.
+func refOf(match fuzzy.Match) ref {
+	return ref{index: match.Index, text: match.Str}
+}
+
 type model struct {
 	textinput             textinput.Model
 	viewport              *viewport.Model
-	choices               map[string]string
-	filteringChoices      []string
+	choices               []choice
+	fields                fields
+	nthFields             []fieldRange
+	withNth               []fieldRange
+	acceptNth             []fieldRange
+	frecency              map[string]float64
 	matches               []fuzzy.Match
 	cursor                int
 	header                string
-	selected              map[string]struct{}
+	selected              map[ref]struct{}
 	limit                 int
 	numSelected           int
 	indicator             string
@@ -146,13 +182,18 @@ type model struct {
 	selectedPrefixStyle   lipgloss.Style
 	unselectedPrefixStyle lipgloss.Style
 	reverse               bool
-	fuzzy                 bool
+	algorithm             string
+	tiebreak              []string
 	sort                  bool
 	showHelp              bool
 	keymap                keymap
 	help                  help.Model
 	strict                bool
 	submitted             bool
+
+	// mouse locates the view to map clicks to matches, if the mouse is
+	// enabled.
+	mouse *mouse.Tracker
 }
 
 func (m model) Init() tea.Cmd { return textinput.Blink }
@@ -195,7 +236,7 @@ func (m model) View() string {
 		}
 
 		// If there are multiple selections mark them, otherwise leave an empty space
-		if _, ok := m.selected[match.Str]; ok {
+		if _, ok := m.selected[refOf(match)]; ok {
 			s.WriteString(m.selectedPrefixStyle.Render(m.selectedPrefix))
 		} else if m.limit > 1 {
 			s.WriteString(m.unselectedPrefixStyle.Render(m.unselectedPrefix))
@@ -203,7 +244,10 @@ func (m model) View() string {
 			s.WriteString(" ")
 		}
 
-		styledOption := m.choices[match.Str]
+		styledOption := match.Str
+		if match.Index >= 0 {
+			styledOption = m.choices[match.Index].styled
+		}
 		if len(match.MatchedIndexes) == 0 {
 			// No matches, just render the text.
 			s.WriteString(lineTextStyle.Render(styledOption))
@@ -243,10 +287,10 @@ func (m model) View() string {
 			view += help
 		}
 		if m.header != "" {
-			return lipgloss.JoinVertical(lipgloss.Left, view, header)
+			return m.mouse.Render(lipgloss.JoinVertical(lipgloss.Left, view, header))
 		}
 
-		return view
+		return m.mouse.Render(view)
 	}
 
 	view := m.textinput.View() + "\n" + m.viewport.View()
@@ -254,9 +298,9 @@ func (m model) View() string {
 		view += help
 	}
 	if m.header != "" {
-		return lipgloss.JoinVertical(lipgloss.Left, header, view)
+		return m.mouse.Render(lipgloss.JoinVertical(lipgloss.Left, header, view))
 	}
-	return view
+	return m.mouse.Render(view)
 }
 
 func (m model) helpView() string {
@@ -265,8 +309,11 @@ func (m model) helpView() string {
 
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd, icmd tea.Cmd
+	m.mouse.Update(msg)
 	m.textinput, icmd = m.textinput.Update(msg)
 	switch msg := msg.(type) {
+	case tea.MouseMsg:
+		return m.updateMouse(msg)
 	case tea.WindowSizeMsg:
 		if m.height == 0 || m.height > msg.Height {
 			m.viewport.Height = msg.Height - lipgloss.Height(m.textinput.View())
@@ -337,45 +384,11 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m = m.deselectAll()
 			}
 		default:
-			// yOffsetFromBottom is the number of lines from the bottom of the
-			// list to the top of the viewport. This is used to keep the viewport
-			// at a constant position when the number of matches are reduced
-			// in the reverse layout.
-			var yOffsetFromBottom int
-			if m.reverse {
-				yOffsetFromBottom = max(0, len(m.matches)-m.viewport.YOffset)
-			}
-
-			// A character was entered, this likely means that the text input has
-			// changed. This suggests that the matches are outdated, so update them.
-			var choices []string
-			if !m.strict {
-				choices = append(choices, m.textinput.Value())
-			}
-			choices = append(choices, m.filteringChoices...)
-			if m.fuzzy {
-				if m.sort {
-					m.matches = fuzzy.Find(m.textinput.Value(), choices)
-				} else {
-					m.matches = fuzzy.FindNoSort(m.textinput.Value(), choices)
-				}
-			} else {
-				m.matches = exactMatches(m.textinput.Value(), choices)
-			}
-
-			// If the search field is empty, let's not display the matches
-			// (none), but rather display all possible choices.
-			if m.textinput.Value() == "" {
-				m.matches = matchAll(m.filteringChoices)
-			}
-
-			// For reverse layout, we need to offset the viewport so that the
-			// it remains at a constant position relative to the cursor.
-			if m.reverse {
-				maxYOffset := max(0, len(m.matches)-m.viewport.Height)
-				m.viewport.YOffset = clamp(0, maxYOffset, len(m.matches)-yOffsetFromBottom)
-			}
+			m.refilter()
 		}
+	case choicesMsg:
+		m.add(msg)
+		m.refilter()
 	}
 
 	m.keymap.FocusInSearch.SetEnabled(!m.textinput.Focused())
@@ -391,6 +404,55 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, tea.Batch(cmd, icmd)
 }
 
+// refilter updates the matches after the query or the choices changed.
+func (m *model) refilter() {
+	// yOffsetFromBottom is the number of lines from the bottom of the
+	// list to the top of the viewport. This is used to keep the viewport
+	// at a constant position when the number of matches are reduced
+	// in the reverse layout.
+	var yOffsetFromBottom int
+	if m.reverse {
+		yOffsetFromBottom = max(0, len(m.matches)-m.viewport.YOffset)
+	}
+
+	// The query or the choices changed, so the matches are outdated.
+	m.matches = m.find(m.textinput.Value(), true)
+
+	// If the search field is empty, let's not display the matches
+	// (none), but rather display all possible choices.
+	if m.textinput.Value() == "" {
+		m.matches = m.boost(matchAll(m.choices))
+	}
+
+	// For reverse layout, we need to offset the viewport so that the
+	// it remains at a constant position relative to the cursor.
+	if m.reverse {
+		maxYOffset := max(0, len(m.matches)-m.viewport.Height)
+		m.viewport.YOffset = clamp(0, maxYOffset, len(m.matches)-yOffsetFromBottom)
+	}
+}
+
+// add adds options to filter.
+func (m *model) add(options []string) {
+	for _, opt := range options {
+		s := ansi.Strip(opt)
+		display := s
+		if m.withNth != nil {
+			display = m.fields.selectFields(s, m.withNth).text
+			opt = display
+		}
+		c := choice{display: display, styled: opt, output: s}
+		if m.acceptNth != nil {
+			c.output = m.fields.selectFields(s, m.acceptNth).text
+		}
+		if m.nthFields != nil {
+			sel := m.fields.selectFields(display, m.nthFields)
+			c.nth = &sel
+		}
+		m.choices = append(m.choices, c)
+	}
+}
+
 func (m *model) CursorUp() {
 	if len(m.matches) == 0 {
 		return
@@ -438,11 +500,12 @@ func (m *model) CursorDown() {
 }
 
 func (m *model) ToggleSelection() {
-	if _, ok := m.selected[m.matches[m.cursor].Str]; ok {
-		delete(m.selected, m.matches[m.cursor].Str)
+	r := refOf(m.matches[m.cursor])
+	if _, ok := m.selected[r]; ok {
+		delete(m.selected, r)
 		m.numSelected--
 	} else if m.numSelected < m.limit {
-		m.selected[m.matches[m.cursor].Str] = struct{}{}
+		m.selected[r] = struct{}{}
 		m.numSelected++
 	}
 }
@@ -452,25 +515,96 @@ func (m model) selectAll() model {
 		if m.numSelected >= m.limit {
 			break // do not exceed given limit
 		}
-		if _, ok := m.selected[m.matches[i].Str]; ok {
+		if _, ok := m.selected[refOf(m.matches[i])]; ok {
 			continue
 		}
-		m.selected[m.matches[i].Str] = struct{}{}
+		m.selected[refOf(m.matches[i])] = struct{}{}
 		m.numSelected++
 	}
 	return m
 }
 
 func (m model) deselectAll() model {
-	m.selected = make(map[string]struct{})
+	m.selected = make(map[ref]struct{})
 	m.numSelected = 0
 	return m
 }
 
-func matchAll(options []string) []fuzzy.Match {
-	matches := make([]fuzzy.Match, len(options))
-	for i, option := range options {
-		matches[i] = fuzzy.Match{Str: option}
+// find returns the choices matching the query, and with typed the query
+// itself unless matches are strict. When filtering on some fields only, those
+// fields are matched and the matched indexes are translated back to the
+// displayed choice. Matches are indexed by choice, and the query by -1.
+func (m model) find(query string, typed bool) []fuzzy.Match {
+	offset := 0
+	var targets []string
+	if typed && !m.strict {
+		targets = append(targets, query)
+		offset = 1
+	}
+	for _, c := range m.choices {
+		if c.nth != nil {
+			targets = append(targets, c.nth.text)
+		} else {
+			targets = append(targets, c.display)
+		}
+	}
+	matches := findMatches(m.algorithm, query, targets, m.sort, m.tiebreak)
+	for i, match := range matches {
+		matches[i].Index = match.Index - offset
+		if matches[i].Index < 0 {
+			continue
+		}
+		c := m.choices[matches[i].Index]
+		if c.nth != nil {
+			matches[i].MatchedIndexes = c.nth.translate(match.MatchedIndexes)
+		}
+		matches[i].Str = c.display
+	}
+	return m.boost(matches)
+}
+
+// historyBonus scales the score bonus of the choices picked before.
+const historyBonus = 10
+
+// boost ranks the frequently and recently picked choices higher. The bonus
+// grows logarithmically so history breaks ties between similar matches
+// rather than burying the best ones.
+func (m model) boost(matches []fuzzy.Match) []fuzzy.Match {
+	if len(m.frecency) == 0 || !m.sort {
+		return matches
+	}
+	for i, match := range matches {
+		if f := m.frecency[match.Str]; f > 0 {
+			matches[i].Score += int(historyBonus * math.Log2(1+f))
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+	return matches
+}
+
+// output returns what to print when the match is picked.
+func (m model) output(r ref) string {
+	if r.index < 0 {
+		return r.text
+	}
+	return m.choices[r.index].output
+}
+
+// selection returns the selected matches, in the order of the input.
+func (m model) selection() []ref {
+	refs := slices.Collect(maps.Keys(m.selected))
+	slices.SortFunc(refs, func(a, b ref) int {
+		return cmp.Or(cmp.Compare(a.index, b.index), strings.Compare(a.text, b.text))
+	})
+	return refs
+}
+
+func matchAll(choices []choice) []fuzzy.Match {
+	matches := make([]fuzzy.Match, len(choices))
+	for i, c := range choices {
+		matches[i] = fuzzy.Match{Str: c.display, Index: i}
 	}
 	return matches
 }
@@ -57,3 +57,137 @@ func TestByteToChar(t *testing.T) {
 		t.Errorf("expected %+q, got %+q", expect, got)
 	}
 }
+
+func TestFindMatches(t *testing.T) {
+	choices := []string{
+		"internal/files/files.go",
+		"filter/filter.go",
+		"filter/filter_test.go",
+		"README.md",
+	}
+	for name, tt := range map[string]struct {
+		algorithm string
+		query     string
+		tiebreak  []string
+		out       []string
+	}{
+		"fzf prefers boundaries": {
+			algorithm: "fzf",
+			query:     "ff",
+			tiebreak:  []string{"length"},
+			out:       []string{"filter/filter.go", "filter/filter_test.go", "internal/files/files.go"},
+		},
+		"fzf smart case": {
+			algorithm: "fzf",
+			query:     "RM",
+			out:       []string{"README.md"},
+		},
+		"substring": {
+			algorithm: "substring",
+			query:     "test",
+			out:       []string{"filter/filter_test.go"},
+		},
+		"regex": {
+			algorithm: "regex",
+			query:     `^f.*\.go$`,
+			tiebreak:  []string{"index"},
+			out:       []string{"filter/filter.go", "filter/filter_test.go"},
+		},
+		"extended and": {
+			algorithm: "extended",
+			query:     "^filter !test",
+			out:       []string{"filter/filter.go"},
+		},
+		"extended or": {
+			algorithm: "extended",
+			query:     "md$ | files.go$",
+			tiebreak:  []string{"index"},
+			out:       []string{"internal/files/files.go", "README.md"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out []string
+			for _, match := range findMatches(tt.algorithm, tt.query, choices, true, tt.tiebreak) {
+				out = append(out, match.Str)
+			}
+			if !reflect.DeepEqual(out, tt.out) {
+				t.Errorf("expected %v, got %v", tt.out, out)
+			}
+		})
+	}
+}
+
+func TestFzfMatchPositions(t *testing.T) {
+	_, positions, ok := fzfMatch([]rune("fg"), newText("foo/bar_fg"))
+	if !ok {
+		t.Fatal("expected a match")
+	}
+	if expect := []int{8, 9}; !reflect.DeepEqual(positions, expect) {
+		t.Errorf("expected %v, got %v", expect, positions)
+	}
+}
+
+func TestSelectFields(t *testing.T) {
+	for name, tt := range map[string]struct {
+		delimiter string
+		expr      string
+		in        string
+		out       string
+	}{
+		"single":       {expr: "2", in: "a  b c", out: "b"},
+		"open end":     {expr: "2..", in: "a b  c", out: "b c"},
+		"from the end": {expr: "..-2", in: "a b c", out: "a b"},
+		"delimiter":    {delimiter: ":", expr: "1,3", in: "root:x:0:0", out: "root:0"},
+		"out of range": {expr: "4", in: "a b", out: ""},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ranges, err := parseFields(tt.expr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sel := fields{delimiter: tt.delimiter}.selectFields(tt.in, ranges)
+			if sel.text != tt.out {
+				t.Errorf("expected %q, got %q", tt.out, sel.text)
+			}
+		})
+	}
+}
+
+func TestDuplicateDisplayedFields(t *testing.T) {
+	withNth, err := parseFields("2..")
+	if err != nil {
+		t.Fatal(err)
+	}
+	acceptNth, err := parseFields("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := model{
+		withNth:   withNth,
+		acceptNth: acceptNth,
+		algorithm: "fzf",
+		limit:     2,
+		selected:  map[ref]struct{}{},
+	}
+	m.add([]string{"a1b2c3 Fix typo", "d4e5f6 Fix typo", "0a1b2c Add tests"})
+
+	m.matches = m.find("typo", false)
+	var out []string
+	for _, match := range m.matches {
+		out = append(out, m.output(refOf(match)))
+	}
+	if expect := []string{"a1b2c3", "d4e5f6"}; !reflect.DeepEqual(out, expect) {
+		t.Errorf("expected %v, got %v", expect, out)
+	}
+
+	for m.cursor = range m.matches {
+		m.ToggleSelection()
+	}
+	out = nil
+	for _, r := range m.selection() {
+		out = append(out, m.output(r))
+	}
+	if expect := []string{"a1b2c3", "d4e5f6"}; !reflect.DeepEqual(out, expect) {
+		t.Errorf("expected the selection %v, got %v", expect, out)
+	}
+}
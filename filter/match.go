@@ -0,0 +1,514 @@
+package filter
+
+import (
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/sahilm/fuzzy"
+)
+
+// Scoring constants of the fzf matching algorithm.
+// See https://github.com/junegunn/fzf/blob/master/src/algo/algo.go
+const (
+	scoreMatch        = 16
+	scoreGapStart     = -3
+	scoreGapExtension = -1
+
+	// Matches at the start of a word (after a space, a delimiter or any
+	// other non-word character) are worth more.
+	bonusBoundary          = scoreMatch / 2
+	bonusBoundaryWhite     = bonusBoundary + 2
+	bonusBoundaryDelimiter = bonusBoundary + 1
+	bonusNonWord           = scoreMatch / 2
+	bonusCamel123          = bonusBoundary + scoreGapExtension
+
+	// A consecutive match is at least worth as much as the gap it avoids.
+	bonusConsecutive = -(scoreGapStart + scoreGapExtension)
+
+	bonusFirstCharMultiplier = 2
+)
+
+// noScore marks impossible matches in the scoring matrix.
+const noScore = -1 << 30
+
+type charClass int
+
+const (
+	charWhite charClass = iota
+	charNonWord
+	charDelimiter
+	charLower
+	charUpper
+	charLetter
+	charNumber
+)
+
+func classOf(r rune) charClass {
+	switch {
+	case unicode.IsSpace(r):
+		return charWhite
+	case strings.ContainsRune("/,:;|", r):
+		return charDelimiter
+	case unicode.IsLower(r):
+		return charLower
+	case unicode.IsUpper(r):
+		return charUpper
+	case unicode.IsNumber(r):
+		return charNumber
+	case unicode.IsLetter(r):
+		return charLetter
+	default:
+		return charNonWord
+	}
+}
+
+func bonusFor(prev, class charClass) int {
+	if class > charNonWord {
+		switch prev {
+		case charWhite:
+			return bonusBoundaryWhite
+		case charDelimiter:
+			return bonusBoundaryDelimiter
+		case charNonWord:
+			return bonusBoundary
+		}
+	}
+	if prev == charLower && class == charUpper ||
+		prev != charNumber && class == charNumber {
+		return bonusCamel123
+	}
+	switch class {
+	case charNonWord, charDelimiter:
+		return bonusNonWord
+	case charWhite:
+		return bonusBoundaryWhite
+	}
+	return 0
+}
+
+// text is a choice prepared for matching.
+type text struct {
+	runes   []rune
+	offsets []int // byte offset of each rune
+	bonus   []int // bonus of matching each rune
+}
+
+func newText(s string) text {
+	t := text{
+		runes:   make([]rune, 0, len(s)),
+		offsets: make([]int, 0, len(s)),
+		bonus:   make([]int, 0, len(s)),
+	}
+	prev := charWhite
+	for i, r := range s {
+		class := classOf(r)
+		t.runes = append(t.runes, r)
+		t.offsets = append(t.offsets, i)
+		t.bonus = append(t.bonus, bonusFor(prev, class))
+		prev = class
+	}
+	return t
+}
+
+// byteIndexes converts rune positions into the byte positions used by
+// fuzzy.Match.
+func (t text) byteIndexes(positions []int) []int {
+	indexes := make([]int, 0, len(positions))
+	for _, pos := range positions {
+		r := t.runes[pos]
+		for b := range utf8.RuneLen(r) {
+			indexes = append(indexes, t.offsets[pos]+b)
+		}
+	}
+	return indexes
+}
+
+// runScore scores a match of consecutive runes.
+func (t text) runScore(start, length int) int {
+	score := 0
+	first := t.bonus[start]
+	for i := start; i < start+length; i++ {
+		bonus := t.bonus[i]
+		if i == start {
+			bonus *= bonusFirstCharMultiplier
+		} else {
+			bonus = max(bonus, bonusConsecutive, first)
+		}
+		score += scoreMatch + bonus
+	}
+	return score
+}
+
+// smartCase reports whether matching the pattern is case sensitive, which is
+// only the case if it has upper case characters.
+func smartCase(pattern []rune) bool {
+	for _, r := range pattern {
+		if unicode.IsUpper(r) {
+			return true
+		}
+	}
+	return false
+}
+
+func equalRune(a, b rune, caseSensitive bool) bool {
+	if caseSensitive {
+		return a == b
+	}
+	return unicode.ToLower(a) == unicode.ToLower(b)
+}
+
+// fzfMatch implements the fzf v2 algorithm: it finds the best scoring
+// occurrence of pattern as a subsequence of t, favoring consecutive
+// characters and characters at word and path boundaries.
+func fzfMatch(pattern []rune, t text) (int, []int, bool) {
+	m, n := len(pattern), len(t.runes)
+	if m == 0 {
+		return 0, nil, true
+	}
+	if m > n {
+		return 0, nil, false
+	}
+	caseSensitive := smartCase(pattern)
+
+	// Bail out early if pattern is not a subsequence of the text.
+	for i, j := 0, 0; i < m; j++ {
+		if j == n {
+			return 0, nil, false
+		}
+		if equalRune(pattern[i], t.runes[j], caseSensitive) {
+			i++
+		}
+	}
+
+	// score[i][j] is the best score of matching pattern[:i+1] in t[:j+1], and
+	// consecutive[i][j] the number of consecutive matched characters ending
+	// at j (0 if pattern[i] is not matched at j).
+	score := make([][]int, m)
+	consecutive := make([][]int, m)
+	for i := range m {
+		score[i] = make([]int, n)
+		consecutive[i] = make([]int, n)
+		inGap := false
+		for j := range n {
+			gap := noScore
+			if j > 0 && score[i][j-1] > noScore {
+				if inGap {
+					gap = score[i][j-1] + scoreGapExtension
+				} else {
+					gap = score[i][j-1] + scoreGapStart
+				}
+			}
+
+			match, run := noScore, 0
+			if equalRune(pattern[i], t.runes[j], caseSensitive) {
+				bonus := t.bonus[j]
+				switch {
+				case i == 0:
+					match, run = scoreMatch+bonus*bonusFirstCharMultiplier, 1
+				case j > 0 && score[i-1][j-1] > noScore:
+					run = consecutive[i-1][j-1] + 1
+					if run > 1 {
+						first := t.bonus[j-run+1]
+						if bonus >= bonusBoundary && bonus > first {
+							// Break the run, this is a better start.
+							run = 1
+						} else {
+							bonus = max(bonus, bonusConsecutive, first)
+						}
+					}
+					match = score[i-1][j-1] + scoreMatch + bonus
+				}
+			}
+
+			if match > noScore && match >= gap {
+				score[i][j], consecutive[i][j] = match, run
+				inGap = false
+			} else {
+				score[i][j] = gap
+				inGap = true
+			}
+		}
+	}
+
+	best, end := noScore, -1
+	for j, s := range score[m-1] {
+		if consecutive[m-1][j] > 0 && s > best {
+			best, end = s, j
+		}
+	}
+	if end < 0 {
+		return 0, nil, false
+	}
+
+	positions := make([]int, m)
+	for i, j := m-1, end; i >= 0; j-- {
+		if consecutive[i][j] > 0 {
+			positions[i] = j
+			i--
+		}
+	}
+	return best, positions, true
+}
+
+// substringMatch finds the best scoring occurrence of pattern in t.
+func substringMatch(pattern []rune, t text) (int, []int, bool) {
+	best, start := noScore, -1
+	for _, i := range occurrences(pattern, t) {
+		if s := t.runScore(i, len(pattern)); s > best {
+			best, start = s, i
+		}
+	}
+	if start < 0 {
+		return 0, nil, false
+	}
+	return best, span(start, len(pattern)), true
+}
+
+// occurrences returns the rune positions where pattern occurs in t.
+func occurrences(pattern []rune, t text) []int {
+	caseSensitive := smartCase(pattern)
+	var found []int
+next:
+	for i := 0; i+len(pattern) <= len(t.runes); i++ {
+		for k, r := range pattern {
+			if !equalRune(r, t.runes[i+k], caseSensitive) {
+				continue next
+			}
+		}
+		found = append(found, i)
+	}
+	return found
+}
+
+func span(start, length int) []int {
+	positions := make([]int, length)
+	for k := range positions {
+		positions[k] = start + k
+	}
+	return positions
+}
+
+// term is a single search term of the extended search syntax.
+type term struct {
+	pattern []rune
+	exact   bool
+	prefix  bool
+	suffix  bool
+	inverse bool
+}
+
+// parseExtended parses an fzf-like extended search query. Space separated
+// terms must all match, while terms separated by " | " are alternatives.
+//
+//	foo     fuzzy match
+//	'foo    exact match
+//	^foo    prefix match
+//	foo$    suffix match
+//	!foo    inverse exact match
+func parseExtended(query string) [][]term {
+	var groups [][]term
+	or := false
+	for _, token := range strings.Fields(query) {
+		if token == "|" {
+			or = len(groups) > 0
+			continue
+		}
+		var t term
+		if strings.HasPrefix(token, "!") {
+			t.inverse, t.exact = true, true
+			token = token[1:]
+		}
+		if strings.HasPrefix(token, "'") {
+			t.exact = true
+			token = token[1:]
+		}
+		if strings.HasPrefix(token, "^") {
+			t.prefix, t.exact = true, true
+			token = token[1:]
+		}
+		if len(token) > 1 && strings.HasSuffix(token, "$") {
+			t.suffix, t.exact = true, true
+			token = token[:len(token)-1]
+		}
+		if token == "" {
+			continue
+		}
+		t.pattern = []rune(token)
+		if or {
+			groups[len(groups)-1] = append(groups[len(groups)-1], t)
+		} else {
+			groups = append(groups, []term{t})
+		}
+		or = false
+	}
+	return groups
+}
+
+func (tm term) match(t text) (int, []int, bool) {
+	var (
+		score     int
+		positions []int
+		ok        bool
+	)
+	n, m := len(t.runes), len(tm.pattern)
+	switch {
+	case tm.prefix && tm.suffix:
+		ok = n == m && len(occurrences(tm.pattern, t)) > 0
+		if ok {
+			score, positions = t.runScore(0, m), span(0, m)
+		}
+	case tm.prefix:
+		ok = n >= m && slices.Contains(occurrences(tm.pattern, t), 0)
+		if ok {
+			score, positions = t.runScore(0, m), span(0, m)
+		}
+	case tm.suffix:
+		ok = n >= m && slices.Contains(occurrences(tm.pattern, t), n-m)
+		if ok {
+			score, positions = t.runScore(n-m, m), span(n-m, m)
+		}
+	case tm.exact:
+		score, positions, ok = substringMatch(tm.pattern, t)
+	default:
+		score, positions, ok = fzfMatch(tm.pattern, t)
+	}
+	if tm.inverse {
+		return 0, nil, !ok
+	}
+	return score, positions, ok
+}
+
+func extendedMatch(groups [][]term, t text) (int, []int, bool) {
+	total := 0
+	var positions []int
+	for _, group := range groups {
+		matched := false
+		for _, tm := range group {
+			if score, pos, ok := tm.match(t); ok {
+				total += score
+				positions = append(positions, pos...)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return 0, nil, false
+		}
+	}
+	sort.Ints(positions)
+	return total, dedup(positions), true
+}
+
+func dedup(s []int) []int {
+	out := s[:0]
+	for i, v := range s {
+		if i == 0 || v != s[i-1] {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// regexMatch matches s against re. As the length of a regular expression
+// match says little about its quality, only its start is scored.
+func regexMatch(re *regexp.Regexp, s string) (int, []int, bool) {
+	loc := re.FindStringIndex(s)
+	if loc == nil {
+		return 0, nil, false
+	}
+	if loc[0] == loc[1] {
+		return 0, nil, true
+	}
+	t := newText(s)
+	start := utf8.RuneCountInString(s[:loc[0]])
+	return t.runScore(start, 1), span(loc[0], loc[1]-loc[0]), true
+}
+
+// findMatches returns the choices matching the query with the given
+// algorithm, sorted by score (and then by the tiebreak criteria) if sorted is
+// set.
+func findMatches(algorithm, query string, choices []string, sorted bool, tiebreak []string) []fuzzy.Match {
+	var matches []fuzzy.Match
+	switch algorithm {
+	case "fuzzy":
+		if sorted {
+			matches = fuzzy.Find(query, choices)
+		} else {
+			matches = fuzzy.FindNoSort(query, choices)
+		}
+	case "substring":
+		matches = exactMatches(query, choices)
+	case "regex":
+		expr := query
+		if !smartCase([]rune(query)) {
+			expr = "(?i)" + expr
+		}
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			// The expression is likely still being typed.
+			return nil
+		}
+		for i, choice := range choices {
+			if score, indexes, ok := regexMatch(re, choice); ok {
+				matches = append(matches, fuzzy.Match{Str: choice, Index: i, MatchedIndexes: indexes, Score: score})
+			}
+		}
+	case "fzf", "extended":
+		groups := [][]term{{{pattern: []rune(query)}}}
+		if algorithm == "extended" {
+			groups = parseExtended(query)
+		}
+		for i, choice := range choices {
+			t := newText(choice)
+			if score, positions, ok := extendedMatch(groups, t); ok {
+				matches = append(matches, fuzzy.Match{Str: choice, Index: i, MatchedIndexes: t.byteIndexes(positions), Score: score})
+			}
+		}
+	}
+	if sorted {
+		rank(matches, tiebreak)
+	}
+	return matches
+}
+
+// rank sorts matches by score, breaking ties with the given criteria:
+//
+//	length  prefer shorter choices
+//	begin   prefer choices where the match starts earlier
+//	index   prefer choices that come first in the input
+func rank(matches []fuzzy.Match, tiebreak []string) {
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		for _, criterion := range tiebreak {
+			switch criterion {
+			case "length":
+				if len(a.Str) != len(b.Str) {
+					return len(a.Str) < len(b.Str)
+				}
+			case "begin":
+				if ab, bb := begin(a), begin(b); ab != bb {
+					return ab < bb
+				}
+			case "index":
+				if a.Index != b.Index {
+					return a.Index < b.Index
+				}
+			}
+		}
+		return false
+	})
+}
+
+func begin(m fuzzy.Match) int {
+	if len(m.MatchedIndexes) == 0 {
+		return 0
+	}
+	return m.MatchedIndexes[0]
+}
@@ -0,0 +1,59 @@
+package filter
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// updateMouse moves the cursor with the wheel, and handles clicks on the
+// matches: they are toggled if several can be chosen, and else the cursor is
+// moved to them, the match under the cursor being submitted.
+func (m model) updateMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	if msg.Action != tea.MouseActionPress {
+		return m, nil
+	}
+	switch msg.Button {
+	case tea.MouseButtonWheelDown:
+		m.CursorDown()
+	case tea.MouseButtonWheelUp:
+		m.CursorUp()
+	case tea.MouseButtonLeft:
+		i := m.matchAt(m.mouse.Line(msg))
+		if i < 0 {
+			break
+		}
+		if m.limit == 1 && i == m.cursor {
+			m.quitting = true
+			m.submitted = true
+			return m, tea.Quit
+		}
+		m.cursor = i
+		if m.limit > 1 {
+			m.ToggleSelection()
+		}
+	}
+	return m, nil
+}
+
+// matchAt returns the index of the match shown on the given line of the
+// view, or -1.
+func (m model) matchAt(line int) int {
+	if !m.reverse {
+		if m.header != "" {
+			line -= lipgloss.Height(m.headerStyle.Render(m.header))
+		}
+		line -= lipgloss.Height(m.textinput.View())
+	}
+	if line < 0 || line >= m.viewport.Height {
+		return -1
+	}
+	i := line + m.viewport.YOffset
+	if m.reverse {
+		// Matches are shown from the bottom, below padding if they are few.
+		i = len(m.matches) - 1 - (i - max(0, m.viewport.Height-len(m.matches)))
+	}
+	if i < 0 || i >= len(m.matches) {
+		return -1
+	}
+	return i
+}
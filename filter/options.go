@@ -10,37 +10,51 @@ import (
 type Options struct {
 	Options []string `arg:"" optional:"" help:"Options to filter."`
 
-	Indicator             string        `help:"Character for selection" default:"•" env:"GUM_FILTER_INDICATOR"`
-	IndicatorStyle        style.Styles  `embed:"" prefix:"indicator." set:"defaultForeground=212" envprefix:"GUM_FILTER_INDICATOR_"`
-	Limit                 int           `help:"Maximum number of options to pick" default:"1" group:"Selection"`
-	NoLimit               bool          `help:"Pick unlimited number of options (ignores limit)" group:"Selection"`
-	SelectIfOne           bool          `help:"Select the given option if there is only one" group:"Selection"`
-	Selected              []string      `help:"Options that should start as selected (selects all if given *)" default:"" env:"GUM_FILTER_SELECTED"`
-	ShowHelp              bool          `help:"Show help keybinds" default:"true" negatable:"" env:"GUM_FILTER_SHOW_HELP"`
-	Strict                bool          `help:"Only returns if anything matched. Otherwise return Filter" negatable:"" default:"true" group:"Selection"`
-	SelectedPrefix        string        `help:"Character to indicate selected items (hidden if limit is 1)" default:" ◉ " env:"GUM_FILTER_SELECTED_PREFIX"`
-	SelectedPrefixStyle   style.Styles  `embed:"" prefix:"selected-indicator." set:"defaultForeground=212" envprefix:"GUM_FILTER_SELECTED_PREFIX_"`
-	UnselectedPrefix      string        `help:"Character to indicate unselected items (hidden if limit is 1)" default:" ○ " env:"GUM_FILTER_UNSELECTED_PREFIX"`
-	UnselectedPrefixStyle style.Styles  `embed:"" prefix:"unselected-prefix." set:"defaultForeground=240" envprefix:"GUM_FILTER_UNSELECTED_PREFIX_"`
-	HeaderStyle           style.Styles  `embed:"" prefix:"header." set:"defaultForeground=99" envprefix:"GUM_FILTER_HEADER_"`
-	Header                string        `help:"Header value" default:"" env:"GUM_FILTER_HEADER"`
-	TextStyle             style.Styles  `embed:"" prefix:"text." envprefix:"GUM_FILTER_TEXT_"`
-	CursorTextStyle       style.Styles  `embed:"" prefix:"cursor-text." envprefix:"GUM_FILTER_CURSOR_TEXT_"`
-	MatchStyle            style.Styles  `embed:"" prefix:"match." set:"defaultForeground=212" envprefix:"GUM_FILTER_MATCH_"`
-	Placeholder           string        `help:"Placeholder value" default:"Filter..." env:"GUM_FILTER_PLACEHOLDER"`
-	Prompt                string        `help:"Prompt to display" default:"> " env:"GUM_FILTER_PROMPT"`
-	PromptStyle           style.Styles  `embed:"" prefix:"prompt." set:"defaultForeground=240" envprefix:"GUM_FILTER_PROMPT_"`
-	PlaceholderStyle      style.Styles  `embed:"" prefix:"placeholder." set:"defaultForeground=240" envprefix:"GUM_FILTER_PLACEHOLDER_"`
-	Width                 int           `help:"Input width" default:"0" env:"GUM_FILTER_WIDTH"`
-	Height                int           `help:"Input height" default:"0" env:"GUM_FILTER_HEIGHT"`
-	Value                 string        `help:"Initial filter value" default:"" env:"GUM_FILTER_VALUE"`
-	Reverse               bool          `help:"Display from the bottom of the screen" env:"GUM_FILTER_REVERSE"`
-	Fuzzy                 bool          `help:"Enable fuzzy matching; otherwise match from start of word" default:"true" env:"GUM_FILTER_FUZZY" negatable:""`
-	FuzzySort             bool          `help:"Sort fuzzy results by their scores" default:"true" env:"GUM_FILTER_FUZZY_SORT" negatable:""`
-	Timeout               time.Duration `help:"Timeout until filter command aborts" default:"0s" env:"GUM_FILTER_TIMEOUT"`
-	InputDelimiter        string        `help:"Option delimiter when reading from STDIN" default:"\n" env:"GUM_FILTER_INPUT_DELIMITER"`
-	OutputDelimiter       string        `help:"Option delimiter when writing to STDOUT" default:"\n" env:"GUM_FILTER_OUTPUT_DELIMITER"`
-	StripANSI             bool          `help:"Strip ANSI sequences when reading from STDIN" default:"true" negatable:"" env:"GUM_FILTER_STRIP_ANSI"`
+	Indicator             string            `help:"Character for selection" default:"•" env:"GUM_FILTER_INDICATOR"`
+	IndicatorStyle        style.Styles      `embed:"" prefix:"indicator." set:"defaultForeground=212" envprefix:"GUM_FILTER_INDICATOR_"`
+	Limit                 int               `help:"Maximum number of options to pick" default:"1" group:"Selection"`
+	NoLimit               bool              `help:"Pick unlimited number of options (ignores limit)" group:"Selection"`
+	SelectIfOne           bool              `help:"Select the given option if there is only one" group:"Selection"`
+	Selected              []string          `help:"Options that should start as selected (selects all if given *)" default:"" env:"GUM_FILTER_SELECTED"`
+	ShowHelp              bool              `help:"Show help keybinds" default:"true" negatable:"" env:"GUM_FILTER_SHOW_HELP"`
+	Keymap                map[string]string `help:"Remap key bindings, as action=keys (e.g. down=ctrl+j,down)" placeholder:"action=keys;..." env:"GUM_FILTER_KEYMAP"`
+	Mouse                 bool              `help:"Enable the mouse, to click and scroll with the wheel" env:"GUM_FILTER_MOUSE"`
+	Strict                bool              `help:"Only returns if anything matched. Otherwise return Filter" negatable:"" default:"true" group:"Selection"`
+	SelectedPrefix        string            `help:"Character to indicate selected items (hidden if limit is 1)" default:" ◉ " env:"GUM_FILTER_SELECTED_PREFIX"`
+	SelectedPrefixStyle   style.Styles      `embed:"" prefix:"selected-indicator." set:"defaultForeground=212" envprefix:"GUM_FILTER_SELECTED_PREFIX_"`
+	UnselectedPrefix      string            `help:"Character to indicate unselected items (hidden if limit is 1)" default:" ○ " env:"GUM_FILTER_UNSELECTED_PREFIX"`
+	UnselectedPrefixStyle style.Styles      `embed:"" prefix:"unselected-prefix." set:"defaultForeground=240" envprefix:"GUM_FILTER_UNSELECTED_PREFIX_"`
+	HeaderStyle           style.Styles      `embed:"" prefix:"header." set:"defaultForeground=99" envprefix:"GUM_FILTER_HEADER_"`
+	Header                string            `help:"Header value" default:"" env:"GUM_FILTER_HEADER"`
+	ID                    string            `help:"Id of the prompt in the answers file (defaults to the header)" default:""`
+	TextStyle             style.Styles      `embed:"" prefix:"text." envprefix:"GUM_FILTER_TEXT_"`
+	CursorTextStyle       style.Styles      `embed:"" prefix:"cursor-text." envprefix:"GUM_FILTER_CURSOR_TEXT_"`
+	MatchStyle            style.Styles      `embed:"" prefix:"match." set:"defaultForeground=212" envprefix:"GUM_FILTER_MATCH_"`
+	Placeholder           string            `help:"Placeholder value" default:"Filter..." env:"GUM_FILTER_PLACEHOLDER"`
+	Prompt                string            `help:"Prompt to display" default:"> " env:"GUM_FILTER_PROMPT"`
+	PromptStyle           style.Styles      `embed:"" prefix:"prompt." set:"defaultForeground=240" envprefix:"GUM_FILTER_PROMPT_"`
+	PlaceholderStyle      style.Styles      `embed:"" prefix:"placeholder." set:"defaultForeground=240" envprefix:"GUM_FILTER_PLACEHOLDER_"`
+	Width                 int               `help:"Input width" default:"0" env:"GUM_FILTER_WIDTH"`
+	Height                int               `help:"Input height" default:"0" env:"GUM_FILTER_HEIGHT"`
+	Value                 string            `help:"Initial filter value" default:"" env:"GUM_FILTER_VALUE"`
+	Reverse               bool              `help:"Display from the bottom of the screen" env:"GUM_FILTER_REVERSE"`
+	Fuzzy                 bool              `help:"Enable fuzzy matching; otherwise match from start of word" default:"true" env:"GUM_FILTER_FUZZY" negatable:""`
+	FuzzySort             bool              `help:"Sort fuzzy results by their scores" default:"true" env:"GUM_FILTER_FUZZY_SORT" negatable:""`
+	Algorithm             string            `help:"Matching algorithm: fuzzy, fzf (fzf v2 scoring), substring, regex or extended (fzf extended search syntax)" enum:"fuzzy,fzf,substring,regex,extended" default:"fuzzy" env:"GUM_FILTER_ALGORITHM"`
+	Tiebreak              []string          `help:"Comma-separated criteria to sort matches with the same score (length, begin, index)" enum:"length,begin,index" default:"index" sep:"," env:"GUM_FILTER_TIEBREAK"`
+	Timeout               time.Duration     `help:"Timeout until filter command aborts" default:"0s" env:"GUM_FILTER_TIMEOUT"`
+	HistoryKey            string            `help:"Save the choices under this key, and rank frequently and recently picked options first" default:"" env:"GUM_FILTER_HISTORY_KEY"`
+	InputDelimiter        string            `help:"Option delimiter when reading from STDIN" default:"\n" env:"GUM_FILTER_INPUT_DELIMITER"`
+	Delimiter             string            `help:"Field delimiter for --nth, --with-nth and --accept-nth (whitespace if empty)" default:"" env:"GUM_FILTER_DELIMITER" group:"Fields"`
+	Nth                   string            `help:"Fields to match against, e.g. 1,3 or 2.. (1-based, negative counts from the end)" default:"" env:"GUM_FILTER_NTH" group:"Fields"`
+	WithNth               string            `help:"Fields to display" default:"" env:"GUM_FILTER_WITH_NTH" group:"Fields"`
+	AcceptNth             string            `help:"Fields to output instead of the whole option" default:"" env:"GUM_FILTER_ACCEPT_NTH" group:"Fields"`
+	Hidden                bool              `help:"Include hidden files when listing the current directory" env:"GUM_FILTER_HIDDEN" group:"Files"`
+	FollowSymlinks        bool              `help:"Follow symbolic links to directories when listing the current directory" env:"GUM_FILTER_FOLLOW_SYMLINKS" group:"Files"`
+	MaxDepth              int               `help:"Maximum depth to list the current directory at (0 for no limit)" default:"0" env:"GUM_FILTER_MAX_DEPTH" group:"Files"`
+	Type                  string            `help:"List files (f) or directories (d) of the current directory" enum:"f,d" default:"f" env:"GUM_FILTER_TYPE" group:"Files"`
+	OutputDelimiter       string            `help:"Option delimiter when writing to STDOUT" default:"\n" env:"GUM_FILTER_OUTPUT_DELIMITER"`
+	StripANSI             bool              `help:"Strip ANSI sequences when reading from STDIN" default:"true" negatable:"" env:"GUM_FILTER_STRIP_ANSI"`
 
 	// Deprecated: use [FuzzySort]. This will be removed at some point.
 	Sort bool `help:"Sort fuzzy results by their scores" default:"true" env:"GUM_FILTER_FUZZY_SORT" negatable:"" hidden:""`
@@ -14,6 +14,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/charmbracelet/glamour/styles"
 	"github.com/charmbracelet/gum/internal/stdin"
 )
 
@@ -29,13 +30,13 @@ func (o Options) Run() error {
 
 	switch o.Type {
 	case "code":
-		output, err = code(input, o.Language)
+		output, err = Code(input, o.Language, styles.AutoStyle)
 	case "emoji":
 		output, err = emoji(input)
 	case "template":
 		output, err = template(input)
 	default:
-		output, err = markdown(input, o.Theme)
+		output, err = Markdown(input, o.Theme, 0)
 	}
 	if err != nil {
 		return err
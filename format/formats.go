@@ -9,9 +9,11 @@ import (
 	"github.com/muesli/termenv"
 )
 
-func code(input, language string) (string, error) {
+// Code renders the input as code of the given language, with the given
+// glamour style ("auto" picks a dark or light style from the terminal).
+func Code(input, language, style string) (string, error) {
 	renderer, err := glamour.NewTermRenderer(
-		glamour.WithAutoStyle(),
+		glamour.WithStandardStyle(style),
 		glamour.WithWordWrap(0),
 	)
 	if err != nil {
@@ -38,10 +40,12 @@ func emoji(input string) (string, error) {
 	return output, nil
 }
 
-func markdown(input string, theme string) (string, error) {
+// Markdown renders the input as markdown with the given glamour theme,
+// wrapping it at the given width (0 to not wrap).
+func Markdown(input, theme string, width int) (string, error) {
 	renderer, err := glamour.NewTermRenderer(
 		glamour.WithStylePath(theme),
-		glamour.WithWordWrap(0),
+		glamour.WithWordWrap(width),
 	)
 	if err != nil {
 		return "", fmt.Errorf("unable to render: %w", err)
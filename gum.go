@@ -6,9 +6,11 @@ import (
 	"github.com/charmbracelet/gum/choose"
 	"github.com/charmbracelet/gum/completion"
 	"github.com/charmbracelet/gum/confirm"
+	"github.com/charmbracelet/gum/date"
 	"github.com/charmbracelet/gum/file"
 	"github.com/charmbracelet/gum/filter"
 	"github.com/charmbracelet/gum/format"
+	"github.com/charmbracelet/gum/history"
 	"github.com/charmbracelet/gum/input"
 	"github.com/charmbracelet/gum/join"
 	"github.com/charmbracelet/gum/log"
@@ -17,6 +19,7 @@ import (
 	"github.com/charmbracelet/gum/spin"
 	"github.com/charmbracelet/gum/style"
 	"github.com/charmbracelet/gum/table"
+	"github.com/charmbracelet/gum/tree"
 	"github.com/charmbracelet/gum/version"
 	"github.com/charmbracelet/gum/write"
 )
@@ -26,6 +29,14 @@ type Gum struct {
 	// Version is a flag that can be used to display the version number.
 	Version kong.VersionFlag `short:"v" help:"Print the version number"`
 
+	// Yes and Answers answer prompts without opening their interface, see
+	// package answer.
+	Yes     bool   `help:"Answer confirmations with yes and other prompts with their default (or set $GUM_ASSUME_YES)"`
+	Answers string `help:"JSON file of answers keyed by prompt id or header" type:"path" env:"GUM_ANSWERS"`
+
+	// Accessible asks prompts line by line, see package accessible.
+	Accessible bool `help:"Ask prompts line by line, for screen readers and dumb terminals (or set $GUM_ACCESSIBLE)"`
+
 	// Completion generates Gum shell completion scripts.
 	Completion completion.Completion `cmd:"" hidden:"" help:"Request shell completion"`
 
@@ -59,6 +70,15 @@ type Gum struct {
 	//
 	Confirm confirm.Options `cmd:"" help:"Ask a user to confirm an action"`
 
+	// Date provides a calendar to pick a date, optionally with a time of day,
+	// or a range of dates. The picked dates are printed with the given
+	// layout.
+	//
+	// $ gum date --min 2025-01-01 --week-start monday
+	// $ gum date --range --format rfc3339
+	//
+	Date date.Options `cmd:"" help:"Pick a date from a calendar"`
+
 	// File provides an interface to pick a file from a folder (tree).
 	// The user is provided a file manager-like interface to navigate, to
 	// select a file.
@@ -91,6 +111,14 @@ type Gum struct {
 	// For more information see the format/README.md file.
 	Format format.Options `cmd:"" help:"Format a string using a template"`
 
+	// History manages the choices saved by the pickers run with --history-key.
+	//
+	// $ gum history list
+	// $ gum history list environments
+	// $ gum history clear environments
+	//
+	History history.Options `cmd:"" help:"Manage the selection history"`
+
 	// Input provides a shell script interface for the text input bubble.
 	// https://github.com/charmbracelet/bubbles/tree/master/textinput
 	//
@@ -197,6 +225,16 @@ type Gum struct {
 	//
 	Table table.Options `cmd:"" help:"Render a table of data"`
 
+	// Tree provides an interface to pick paths of a tree, read as paths,
+	// indented lines or JSON. Branches are expanded and collapsed, and
+	// searched through with /.
+	//
+	// Let's pick some files of the repository:
+	//
+	// $ git ls-files | gum tree --no-limit
+	//
+	Tree tree.Options `cmd:"" help:"Pick paths of a tree"`
+
 	// Write provides a shell script interface for the text area bubble.
 	// https://github.com/charmbracelet/bubbles/tree/master/textarea
 	//
@@ -0,0 +1,37 @@
+package history
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/gum/internal/history"
+)
+
+// Run lists the history keys, or the items of the given key from the most to
+// the least frecent.
+func (o List) Run() error {
+	if o.Key == "" {
+		keys, err := history.Keys()
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			fmt.Println(key)
+		}
+		return nil
+	}
+
+	entries, err := history.Entries(o.Key)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		fmt.Printf("%d\t%s\t%s\n", e.Count, e.Last.Format(time.DateTime), e.Item)
+	}
+	return nil
+}
+
+// Run clears the history.
+func (o Clear) Run() error {
+	return history.Clear(o.Keys...)
+}
@@ -0,0 +1,17 @@
+package history
+
+// Options is the set of subcommands managing the selection history.
+type Options struct {
+	List  List  `cmd:"" help:"List the history keys, or the items picked under a key"`
+	Clear Clear `cmd:"" help:"Clear the history of the given keys, or all of it"`
+}
+
+// List is the options of the list subcommand.
+type List struct {
+	Key string `arg:"" optional:"" help:"History key"`
+}
+
+// Clear is the options of the clear subcommand.
+type Clear struct {
+	Keys []string `arg:"" optional:"" help:"History keys"`
+}
@@ -0,0 +1,61 @@
+package input
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/gum/internal/accessible"
+)
+
+// ask reads the value on a line, asking again until it is valid. Nothing
+// typed submits the initial value.
+func (m model) ask(prompt string) (string, error) {
+	if m.header != "" {
+		accessible.Println(m.header)
+	}
+	read := accessible.ReadLine
+	initial := m.initial()
+	if m.kind == "secret" {
+		read = accessible.ReadSecret
+	} else if initial != "" {
+		prompt = strings.TrimRight(prompt, " ") + " [" + initial + "] "
+	}
+
+	for {
+		value, ok, err := m.read(read, prompt, initial)
+		if err != nil {
+			return "", err
+		}
+		if !ok {
+			continue
+		}
+		if !m.confirm {
+			return value, nil
+		}
+		again, ok, err := m.read(read, confirmPlaceholder+" ", initial)
+		if err != nil {
+			return "", err
+		}
+		if ok && again == value {
+			return value, nil
+		}
+		accessible.Println("The values do not match, try again.")
+	}
+}
+
+// read reads a value, and returns its normalized form, and whether it is
+// valid.
+func (m model) read(read func(string) (string, error), prompt, initial string) (string, bool, error) {
+	line, err := read(prompt)
+	if err != nil {
+		return "", false, err
+	}
+	if line == "" {
+		line = initial
+	}
+	value, err := m.check(line)
+	if err != nil {
+		accessible.Println("Invalid value: " + err.Error())
+		return "", false, nil
+	}
+	return value, true, nil
+}
@@ -19,6 +19,49 @@ func Prompt(prompt string) func(*Options) {
 	return func(o *Options) { o.Prompt = prompt }
 }
 
+// HistoryFile sets the file of previous entries to walk through with the up
+// and down arrows. The submitted value is appended to it.
+func HistoryFile(path string) func(*Options) {
+	return func(o *Options) { o.HistoryFile = path }
+}
+
+// Suggestions sets the function returning the suggestions for the current
+// value. It is called again as the value changes.
+func Suggestions(fn func(prefix string) []string) func(*Options) {
+	return func(o *Options) { o.SuggestFn = fn }
+}
+
+// Type sets the type of the value: text, secret, int, float, date, time or
+// duration.
+func Type(kind string) func(*Options) {
+	return func(o *Options) { o.Type = kind }
+}
+
+// Confirm asks for the value twice.
+func Confirm() func(*Options) {
+	return func(o *Options) { o.Confirm = true }
+}
+
+// Bounds sets the minimum and maximum values, empty for no bound.
+func Bounds(lo, hi string) func(*Options) {
+	return func(o *Options) { o.Min, o.Max = lo, hi }
+}
+
+// Step sets the amount the up and down arrows add to numbers.
+func Step(step float64) func(*Options) {
+	return func(o *Options) { o.Step = step }
+}
+
+// Format sets the layout of dates and times.
+func Format(layout string) func(*Options) {
+	return func(o *Options) { o.Format = layout }
+}
+
+// ID is the key of the prompt in the answers file, instead of the header.
+func ID(id string) func(*Options) {
+	return func(o *Options) { o.ID = id }
+}
+
 func Input(optionsFn ...func(*Options)) (string, error) {
 	option := &Options{}
 	bingoo.KongParse(option, bingoo.KongVars)
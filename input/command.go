@@ -4,11 +4,18 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/gum/cursor"
+	"github.com/charmbracelet/gum/internal/accessible"
+	"github.com/charmbracelet/gum/internal/answer"
+	"github.com/charmbracelet/gum/internal/bindings"
+	"github.com/charmbracelet/gum/internal/calendar"
+	"github.com/charmbracelet/gum/internal/editor"
 	"github.com/charmbracelet/gum/internal/stdin"
 	"github.com/charmbracelet/gum/internal/timeout"
 )
@@ -39,19 +46,91 @@ func (o Options) RunBingoo() (string, error) {
 	i.CharLimit = o.CharLimit
 
 	if o.Password {
+		o.Type = "secret"
+	}
+	if o.Type == "secret" {
 		i.EchoMode = textinput.EchoPassword
 		i.EchoCharacter = '•'
 	}
 
+	var history []string
+	if o.HistoryFile != "" {
+		var err error
+		if history, err = readHistory(o.HistoryFile); err != nil {
+			return "", err
+		}
+	}
+
+	var suggestions []string
+	for _, s := range o.Suggestions {
+		if s != "" {
+			suggestions = append(suggestions, s)
+		}
+	}
+	if len(suggestions) > 0 || o.SuggestFn != nil || o.SuggestionsCommand != "" {
+		i.ShowSuggestions = true
+		i.CompletionStyle = o.SuggestionStyle.ToLipgloss()
+	}
+
 	m := model{
-		textinput:   i,
-		header:      o.Header,
-		headerStyle: o.HeaderStyle.ToLipgloss(),
-		autoWidth:   o.Width < 1,
-		showHelp:    o.ShowHelp,
-		help:        help.New(),
-		keymap:      defaultKeymap(),
+		textinput:               i,
+		header:                  o.Header,
+		headerStyle:             o.HeaderStyle.ToLipgloss(),
+		autoWidth:               o.Width < 1,
+		showHelp:                o.ShowHelp,
+		help:                    help.New(),
+		keymap:                  defaultKeymap(),
+		history:                 history,
+		historyIndex:            len(history),
+		suggestions:             suggestions,
+		suggestFn:               o.SuggestFn,
+		suggestionsCommand:      o.SuggestionsCommand,
+		suggestionStyle:         o.SuggestionStyle.ToLipgloss(),
+		selectedSuggestionStyle: o.SelectedSuggestionStyle.ToLipgloss(),
+		kind:                    o.Type,
+		parse:                   parseText,
+		confirm:                 o.Confirm,
+		placeholder:             o.Placeholder,
+		errorStyle:              o.ErrorStyle.ToLipgloss(),
+		editor: editor.Options{
+			Name:    editor.TempName(o.EditorExtension),
+			Command: o.Editor,
+		},
+		editorSubmit: o.EditorSubmit,
+	}
+	// Secrets are not written to disk, and dates and times are picked.
+	switch o.Type {
+	case "text", "int", "float", "duration":
+		m.keymap.OpenInEditor.SetEnabled(true)
+	}
+	if err := m.setType(o); err != nil {
+		return "", err
+	}
+	if err := bindings.Remap("input", o.Keymap, &m.keymap, &m.date.KeyMap, &m.clock.KeyMap); err != nil {
+		return "", err
+	}
+	m.textinput.KeyMap = m.keymap.KeyMap
+	prompt := answer.Prompt{Command: "input", ID: answer.Key(o.ID, o.Header)}
+	if value, ok, err := m.answer(prompt); ok || err != nil {
+		if err != nil {
+			return "", err
+		}
+		return value, o.record(prompt, value)
 	}
+	if accessible.On() {
+		value, err := m.ask(o.Prompt)
+		if err != nil {
+			return "", err
+		}
+		return o.finish(prompt, history, value)
+	}
+
+	// The suggestions command is first run by Init.
+	var dynamic []string
+	if o.SuggestFn != nil {
+		dynamic = o.SuggestFn(i.Value())
+	}
+	m.setSuggestions(dynamic)
 
 	ctx, cancel := timeout.Context(o.Timeout)
 	defer cancel()
@@ -71,5 +150,134 @@ func (o Options) RunBingoo() (string, error) {
 	if !m.submitted {
 		return "", errors.New("not submitted")
 	}
-	return m.textinput.Value(), nil
+	return o.finish(prompt, history, m.value)
+}
+
+// finish adds the submitted value to the history, and records it.
+func (o Options) finish(prompt answer.Prompt, history []string, value string) (string, error) {
+	if o.HistoryFile != "" && o.Type != "secret" {
+		if err := appendHistory(o.HistoryFile, history, value); err != nil {
+			return "", err
+		}
+	}
+	return value, o.record(prompt, value)
+}
+
+// record records the value, see answer.Record. Secrets are recorded empty.
+func (o Options) record(prompt answer.Prompt, value string) error {
+	if o.Type == "secret" {
+		value = ""
+	}
+	prompt.Answer = []string{value}
+	return answer.Record(prompt)
+}
+
+// answer checks the answer from the recording or the answers file, or
+// submits the initial value when assuming yes.
+func (m model) answer(prompt answer.Prompt) (string, bool, error) {
+	answers, ok, err := answer.Lookup(prompt)
+	if err != nil {
+		return "", false, err
+	}
+	if !ok {
+		if !answer.Yes() {
+			return "", false, nil
+		}
+		answers = []string{m.initial()}
+	}
+	if len(answers) != 1 {
+		return "", false, fmt.Errorf("expected one answer to %q, got %d", prompt.ID, len(answers))
+	}
+	value, err := m.check(answers[0])
+	if err != nil {
+		return "", false, fmt.Errorf("invalid answer %q: %w", answers[0], err)
+	}
+	return value, true, nil
+}
+
+// initial returns the value the input starts with.
+func (m model) initial() string {
+	switch m.kind {
+	case "date":
+		return m.date.Cursor().Format(m.layout)
+	case "time":
+		return m.clock.Time().Format(m.layout)
+	}
+	return m.textinput.Value()
+}
+
+// check parses a typed value, and returns its normalized form.
+func (m model) check(value string) (string, error) {
+	switch m.kind {
+	case "date", "time":
+		t, err := parseTime(m.layout, m.kind, value)
+		if err != nil {
+			return "", err
+		}
+		if m.kind == "date" && (!m.date.Min.IsZero() && t.Before(m.date.Min) || !m.date.Max.IsZero() && t.After(m.date.Max)) ||
+			m.kind == "time" && !m.clock.InRange(t) {
+			return "", errors.New("out of range")
+		}
+		return t.Format(m.layout), nil
+	}
+	return m.parse(value)
+}
+
+// setType sets up the checks and pickers of the input type.
+func (m *model) setType(o Options) error {
+	switch o.Type {
+	case "int", "float":
+		n, err := newNumbers(o)
+		if err != nil {
+			return err
+		}
+		m.numbers = n
+		m.parse = n.parse
+		m.keymap.Increment.SetEnabled(true)
+		m.keymap.Decrement.SetEnabled(true)
+	case "duration":
+		parse, err := newDurationParser(o)
+		if err != nil {
+			return err
+		}
+		m.parse = parse
+	case "date", "time":
+		layout := o.layout()
+		m.layout = layout
+		parse := func(s string) (time.Time, error) { return parseTime(layout, o.Type, s) }
+		low, high, err := bounds(o.Min, o.Max, parse)
+		if err != nil {
+			return err
+		}
+		value := time.Now()
+		if o.Value != "" {
+			if value, err = parse(o.Value); err != nil {
+				return fmt.Errorf("invalid value %q: %w", o.Value, err)
+			}
+		}
+		if o.Type == "time" {
+			m.clock = calendar.NewClock(value)
+			m.clock.Seconds = strings.Contains(m.layout, "05")
+			if low != nil {
+				m.clock.Min = *low
+			}
+			if high != nil {
+				m.clock.Max = *high
+			}
+			m.clock.SetTime(value)
+			return nil
+		}
+		m.date = calendar.New(value)
+		if m.date.WeekStart, err = calendar.ParseWeekday(o.WeekStart); err != nil {
+			return err
+		}
+		if low != nil {
+			m.date.Min = *low
+		}
+		if high != nil {
+			m.date.Max = *high
+		}
+		m.date.SetCursor(value)
+	}
+	return nil
 }
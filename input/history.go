@@ -0,0 +1,88 @@
+package input
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// readHistory returns the entries of the history file, oldest first.
+func readHistory(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read history: %w", err)
+	}
+	defer f.Close() //nolint:errcheck
+
+	var entries []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || (len(entries) > 0 && entries[len(entries)-1] == line) {
+			continue
+		}
+		entries = append(entries, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read history: %w", err)
+	}
+	return entries, nil
+}
+
+// appendHistory adds the value to the history file, unless it repeats the
+// last entry.
+func appendHistory(path string, entries []string, value string) error {
+	if value == "" || strings.ContainsRune(value, '\n') {
+		return nil
+	}
+	if len(entries) > 0 && entries[len(entries)-1] == value {
+		return nil
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return fmt.Errorf("unable to write history: %w", err)
+	}
+	if _, err := fmt.Fprintln(f, value); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("unable to write history: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("unable to write history: %w", err)
+	}
+	return nil
+}
+
+// suggestionsMsg carries the suggestions computed for a value.
+type suggestionsMsg struct {
+	value       string
+	suggestions []string
+}
+
+// runSuggestions runs the suggestions command for the given value. Failures
+// simply leave no suggestions.
+func runSuggestions(command, value string) tea.Cmd {
+	return func() tea.Msg {
+		shell := os.Getenv("SHELL")
+		if shell == "" {
+			shell = "sh"
+		}
+		cmd := exec.Command(shell, "-c", command)
+		cmd.Env = append(os.Environ(), "GUM_INPUT_VALUE="+value)
+		out, err := cmd.Output()
+		if err != nil {
+			return suggestionsMsg{value: value}
+		}
+		return suggestionsMsg{
+			value:       value,
+			suggestions: strings.Split(strings.TrimRight(string(out), "\n"), "\n"),
+		}
+	}
+}
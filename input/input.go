@@ -8,18 +8,65 @@
 package input
 
 import (
+	"errors"
+	"strings"
+
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/gum/internal/bindings"
+	"github.com/charmbracelet/gum/internal/calendar"
+	"github.com/charmbracelet/gum/internal/editor"
 	"github.com/charmbracelet/lipgloss"
 )
 
-type keymap textinput.KeyMap
+type keymap struct {
+	textinput.KeyMap
+	Submit       key.Binding
+	Quit         key.Binding
+	Abort        key.Binding
+	OpenInEditor key.Binding
+	HistoryPrev  key.Binding
+	HistoryNext  key.Binding
+	Increment    key.Binding
+	Decrement    key.Binding
+}
 
 func defaultKeymap() keymap {
-	k := textinput.DefaultKeyMap
-	return keymap(k)
+	return keymap{
+		KeyMap: textinput.DefaultKeyMap,
+		Submit: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "submit"),
+		),
+		Quit: key.NewBinding(
+			key.WithKeys("esc"),
+		),
+		Abort: key.NewBinding(
+			key.WithKeys("ctrl+c"),
+		),
+		// ctrl+e moves to the end of the line.
+		OpenInEditor: key.NewBinding(
+			key.WithKeys("alt+e"),
+			key.WithHelp("alt+e", "open editor"),
+			key.WithDisabled(),
+		),
+		HistoryPrev: key.NewBinding(
+			key.WithKeys("up"),
+		),
+		HistoryNext: key.NewBinding(
+			key.WithKeys("down"),
+		),
+		Increment: key.NewBinding(
+			key.WithKeys("up"),
+			key.WithDisabled(),
+		),
+		Decrement: key.NewBinding(
+			key.WithKeys("down"),
+			key.WithDisabled(),
+		),
+	}
 }
 
 // FullHelp implements help.KeyMap.
@@ -28,13 +75,15 @@ func (k keymap) FullHelp() [][]key.Binding { return nil }
 // ShortHelp implements help.KeyMap.
 func (k keymap) ShortHelp() []key.Binding {
 	return []key.Binding{
-		key.NewBinding(
-			key.WithKeys("enter"),
-			key.WithHelp("enter", "submit"),
-		),
+		bindings.Help("step", k.Increment, k.Decrement),
+		k.OpenInEditor,
+		k.Submit,
 	}
 }
 
+// maxSuggestions is the height of the suggestions dropdown.
+const maxSuggestions = 5
+
 type model struct {
 	autoWidth   bool
 	header      string
@@ -45,52 +94,329 @@ type model struct {
 	showHelp    bool
 	help        help.Model
 	keymap      keymap
+
+	editor       editor.Options
+	editorSubmit bool
+
+	// history holds the previous entries, oldest first. historyIndex is the
+	// entry being shown, or len(history) while editing draft.
+	history      []string
+	historyIndex int
+	draft        string
+
+	suggestions             []string
+	suggestFn               func(prefix string) []string
+	suggestionsCommand      string
+	suggestionStyle         lipgloss.Style
+	selectedSuggestionStyle lipgloss.Style
+
+	// kind is the type of the value. Dates and times are picked with date
+	// and clock, other values are typed, checked by parse and stepped by
+	// numbers when they are numbers.
+	kind       string
+	parse      parser
+	numbers    numbers
+	date       calendar.Model
+	clock      calendar.Clock
+	layout     string
+	err        error
+	errorStyle lipgloss.Style
+	value      string
+
+	// confirm asks for the value twice, first holding the first one.
+	confirm     bool
+	confirming  bool
+	first       string
+	placeholder string
 }
 
-func (m model) Init() tea.Cmd { return textinput.Blink }
+// confirmPlaceholder replaces the placeholder while confirming the value.
+const confirmPlaceholder = "Type it again to confirm..."
+
+func (m model) Init() tea.Cmd {
+	if m.suggestionsCommand != "" {
+		return tea.Batch(textinput.Blink, runSuggestions(m.suggestionsCommand, m.textinput.Value()))
+	}
+	return textinput.Blink
+}
 
 func (m model) View() string {
 	if m.quitting {
 		return ""
 	}
+	input := m.inputView()
+	if m.err != nil {
+		input = lipgloss.JoinVertical(lipgloss.Left, input, m.errorStyle.Render(m.err.Error()))
+	}
+	if dropdown := m.dropdownView(); dropdown != "" {
+		input = lipgloss.JoinVertical(lipgloss.Left, input, dropdown)
+	}
 	if m.header != "" {
 		header := m.headerStyle.Render(m.header)
-		return lipgloss.JoinVertical(lipgloss.Left, header, m.textinput.View())
+		return lipgloss.JoinVertical(lipgloss.Left, header, input)
 	}
 
 	if !m.showHelp {
-		return m.textinput.View()
+		return input
 	}
 	return lipgloss.JoinVertical(
 		lipgloss.Top,
-		m.textinput.View(),
+		input,
 		"",
-		m.help.View(m.keymap),
+		m.helpView(),
 	)
 }
 
+func (m model) inputView() string {
+	prompt := m.textinput.PromptStyle.Render(m.textinput.Prompt)
+	switch m.kind {
+	case "date":
+		return lipgloss.JoinVertical(
+			lipgloss.Left,
+			prompt+m.date.Cursor().Format(m.layout),
+			"",
+			m.date.View(),
+		)
+	case "time":
+		return prompt + m.clock.View()
+	default:
+		return m.textinput.View()
+	}
+}
+
+func (m model) helpView() string {
+	switch m.kind {
+	case "date":
+		return m.help.ShortHelpView(append(m.date.KeyMap.ShortHelp(), m.keymap.ShortHelp()...))
+	case "time":
+		return m.help.ShortHelpView(append(m.clock.KeyMap.ShortHelp(), m.keymap.ShortHelp()...))
+	default:
+		return m.help.View(m.keymap)
+	}
+}
+
+// submit checks the value, asks for it again when it must be confirmed, and
+// quits with its normalized form.
+func (m model) submit() (tea.Model, tea.Cmd) {
+	var value string
+	switch m.kind {
+	case "date":
+		value = m.date.Cursor().Format(m.layout)
+	case "time":
+		value = m.clock.Time().Format(m.layout)
+	default:
+		var err error
+		if value, err = m.parse(m.textinput.Value()); err != nil {
+			m.err = err
+			return m, nil
+		}
+	}
+
+	if m.confirm {
+		m.err = nil
+		if !m.confirming {
+			m.confirming = true
+			m.first = value
+			m.textinput.Reset()
+			m.textinput.Placeholder = confirmPlaceholder
+			return m, nil
+		}
+		if value != m.first {
+			m.err = errors.New("the values do not match, try again")
+			m.confirming = false
+			m.textinput.Reset()
+			m.textinput.Placeholder = m.placeholder
+			return m, nil
+		}
+	}
+
+	m.value = value
+	m.quitting = true
+	m.submitted = true
+	return m, tea.Quit
+}
+
+// dropdownView lists the suggestions matching the value when there is more
+// than one, the first one being already shown as ghost text.
+func (m model) dropdownView() string {
+	matches := m.matchedSuggestions()
+	if len(matches) < 2 {
+		return ""
+	}
+	current := m.textinput.CurrentSuggestion()
+	selected := 0
+	for i, match := range matches {
+		if match == current {
+			selected = i
+			break
+		}
+	}
+	start := clamp(selected-maxSuggestions/2, 0, max(0, len(matches)-maxSuggestions))
+	end := min(start+maxSuggestions, len(matches))
+
+	indent := strings.Repeat(" ", lipgloss.Width(m.textinput.Prompt))
+	lines := make([]string, 0, end-start)
+	for i := start; i < end; i++ {
+		if i == selected {
+			lines = append(lines, indent+m.selectedSuggestionStyle.Render(matches[i]))
+		} else {
+			lines = append(lines, indent+m.suggestionStyle.Render(matches[i]))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// matchedSuggestions returns the suggestions completing the value, the same
+// way the text input matches them.
+func (m model) matchedSuggestions() []string {
+	value := strings.ToLower(m.textinput.Value())
+	if value == "" {
+		return nil
+	}
+	var matches []string
+	for _, suggestion := range m.textinput.AvailableSuggestions() {
+		if strings.HasPrefix(strings.ToLower(suggestion), value) {
+			matches = append(matches, suggestion)
+		}
+	}
+	return matches
+}
+
+// setSuggestions replaces the dynamic suggestions, keeping the static ones.
+func (m *model) setSuggestions(dynamic []string) {
+	suggestions := make([]string, 0, len(m.suggestions)+len(dynamic))
+	suggestions = append(suggestions, m.suggestions...)
+	for _, s := range dynamic {
+		if s != "" {
+			suggestions = append(suggestions, s)
+		}
+	}
+	m.textinput.SetSuggestions(suggestions)
+}
+
+// refreshSuggestions recomputes the dynamic suggestions for the value.
+func (m *model) refreshSuggestions() tea.Cmd {
+	value := m.textinput.Value()
+	if m.suggestFn != nil {
+		m.setSuggestions(m.suggestFn(value))
+	}
+	if m.suggestionsCommand != "" {
+		return runSuggestions(m.suggestionsCommand, value)
+	}
+	return nil
+}
+
+// walkHistory shows the previous (or next) history entry.
+func (m model) walkHistory(previous bool) model {
+	switch {
+	case previous && m.historyIndex > 0:
+		if m.historyIndex == len(m.history) {
+			m.draft = m.textinput.Value()
+		}
+		m.historyIndex--
+	case !previous && m.historyIndex < len(m.history):
+		m.historyIndex++
+	default:
+		return m
+	}
+	if m.historyIndex == len(m.history) {
+		m.textinput.SetValue(m.draft)
+	} else {
+		m.textinput.SetValue(m.history[m.historyIndex])
+	}
+	m.textinput.CursorEnd()
+	return m
+}
+
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
 		if m.autoWidth {
 			m.textinput.Width = msg.Width - lipgloss.Width(m.textinput.Prompt) - 1
 		}
-	case tea.KeyMsg:
-		switch msg.String() {
-		case "ctrl+c":
+	case suggestionsMsg:
+		// Drop the suggestions of a value that has been edited since.
+		if msg.value == m.textinput.Value() {
+			m.setSuggestions(msg.suggestions)
+		}
+		return m, nil
+	case editor.FinishedMsg:
+		if msg.Err != nil {
 			m.quitting = true
 			return m, tea.Interrupt
-		case "esc":
+		}
+		m.textinput.SetValue(strings.TrimRight(msg.Content, "\r\n"))
+		m.textinput.CursorEnd()
+		m.historyIndex = len(m.history)
+		m.err = nil
+		if value := m.textinput.Value(); value != "" {
+			_, m.err = m.parse(value)
+		}
+		if m.editorSubmit {
+			return m.submit()
+		}
+		return m, nil
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.keymap.Abort):
 			m.quitting = true
-			return m, tea.Quit
-		case "enter":
+			return m, tea.Interrupt
+		case key.Matches(msg, m.keymap.Quit):
 			m.quitting = true
-			m.submitted = true
 			return m, tea.Quit
+		case key.Matches(msg, m.keymap.Submit):
+			return m.submit()
+		case key.Matches(msg, m.keymap.OpenInEditor):
+			return m, editor.Open(m.textinput.Value(), m.editor)
+		}
+		switch m.kind {
+		case "date":
+			m.date, _ = m.date.Update(msg)
+			return m, nil
+		case "time":
+			m.clock, _ = m.clock.Update(msg)
+			return m, nil
+		}
+		switch {
+		case key.Matches(msg, m.keymap.Increment, m.keymap.Decrement):
+			times := 1
+			if key.Matches(msg, m.keymap.Decrement) {
+				times = -1
+			}
+			m.textinput.SetValue(m.numbers.add(m.textinput.Value(), times))
+			m.err = nil
+			return m, nil
+		case key.Matches(msg, m.keymap.HistoryPrev, m.keymap.HistoryNext):
+			// The arrows pick a suggestion when there are some, unless an
+			// entry of the history is being shown.
+			browsing := m.historyIndex < len(m.history)
+			if browsing || len(m.matchedSuggestions()) == 0 {
+				return m.walkHistory(key.Matches(msg, m.keymap.HistoryPrev)), nil
+			}
 		}
 	}
 
+	value := m.textinput.Value()
 	var cmd tea.Cmd
 	m.textinput, cmd = m.textinput.Update(msg)
+	if m.textinput.Value() != value {
+		m.historyIndex = len(m.history)
+		// Check the value as it is typed.
+		m.err = nil
+		if value := m.textinput.Value(); value != "" {
+			_, m.err = m.parse(value)
+		}
+		cmd = tea.Batch(cmd, m.refreshSuggestions())
+	}
 	return m, cmd
 }
+
+func clamp(x, low, high int) int {
+	if x < low {
+		return low
+	}
+	if x > high {
+		return high
+	}
+	return x
+}
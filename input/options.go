@@ -8,19 +8,42 @@ import (
 
 // Options are the customization options for the input.
 type Options struct {
-	Placeholder      string        `help:"Placeholder value" default:"Type something..." env:"GUM_INPUT_PLACEHOLDER"`
-	Prompt           string        `help:"Prompt to display" default:"> " env:"GUM_INPUT_PROMPT"`
-	PromptStyle      style.Styles  `embed:"" prefix:"prompt." envprefix:"GUM_INPUT_PROMPT_"`
-	PlaceholderStyle style.Styles  `embed:"" prefix:"placeholder." set:"defaultForeground=240" envprefix:"GUM_INPUT_PLACEHOLDER_"`
-	CursorStyle      style.Styles  `embed:"" prefix:"cursor." set:"defaultForeground=212" envprefix:"GUM_INPUT_CURSOR_"`
-	CursorMode       string        `prefix:"cursor." name:"mode" help:"Cursor mode" default:"blink" enum:"blink,hide,static" env:"GUM_INPUT_CURSOR_MODE"`
-	Value            string        `help:"Initial value (can also be passed via stdin)" default:""`
-	CharLimit        int           `help:"Maximum value length (0 for no limit)" default:"400"`
-	Width            int           `help:"Input width (0 for terminal width)" default:"0" env:"GUM_INPUT_WIDTH"`
-	Password         bool          `help:"Mask input characters" default:"false"`
-	ShowHelp         bool          `help:"Show help keybinds" default:"true" negatable:"" env:"GUM_INPUT_SHOW_HELP"`
-	Header           string        `help:"Header value" default:"" env:"GUM_INPUT_HEADER"`
-	HeaderStyle      style.Styles  `embed:"" prefix:"header." set:"defaultForeground=240" envprefix:"GUM_INPUT_HEADER_"`
-	Timeout          time.Duration `help:"Timeout until input aborts" default:"0s" env:"GUM_INPUT_TIMEOUT"`
-	StripANSI        bool          `help:"Strip ANSI sequences when reading from STDIN" default:"true" negatable:"" env:"GUM_INPUT_STRIP_ANSI"`
+	Placeholder      string            `help:"Placeholder value" default:"Type something..." env:"GUM_INPUT_PLACEHOLDER"`
+	Prompt           string            `help:"Prompt to display" default:"> " env:"GUM_INPUT_PROMPT"`
+	PromptStyle      style.Styles      `embed:"" prefix:"prompt." envprefix:"GUM_INPUT_PROMPT_"`
+	PlaceholderStyle style.Styles      `embed:"" prefix:"placeholder." set:"defaultForeground=240" envprefix:"GUM_INPUT_PLACEHOLDER_"`
+	CursorStyle      style.Styles      `embed:"" prefix:"cursor." set:"defaultForeground=212" envprefix:"GUM_INPUT_CURSOR_"`
+	CursorMode       string            `prefix:"cursor." name:"mode" help:"Cursor mode" default:"blink" enum:"blink,hide,static" env:"GUM_INPUT_CURSOR_MODE"`
+	Value            string            `help:"Initial value (can also be passed via stdin)" default:""`
+	CharLimit        int               `help:"Maximum value length (0 for no limit)" default:"400"`
+	Width            int               `help:"Input width (0 for terminal width)" default:"0" env:"GUM_INPUT_WIDTH"`
+	Password         bool              `help:"Mask input characters (same as --type secret)" default:"false"`
+	ShowHelp         bool              `help:"Show help keybinds" default:"true" negatable:"" env:"GUM_INPUT_SHOW_HELP"`
+	Keymap           map[string]string `help:"Remap key bindings, as action=keys (e.g. down=ctrl+j,down)" placeholder:"action=keys;..." env:"GUM_INPUT_KEYMAP"`
+	Header           string            `help:"Header value" default:"" env:"GUM_INPUT_HEADER"`
+	ID               string            `help:"Id of the prompt in the answers file (defaults to the header)" default:""`
+	HeaderStyle      style.Styles      `embed:"" prefix:"header." set:"defaultForeground=240" envprefix:"GUM_INPUT_HEADER_"`
+	Timeout          time.Duration     `help:"Timeout until input aborts" default:"0s" env:"GUM_INPUT_TIMEOUT"`
+	StripANSI        bool              `help:"Strip ANSI sequences when reading from STDIN" default:"true" negatable:"" env:"GUM_INPUT_STRIP_ANSI"`
+
+	Type       string       `help:"Type of the value" enum:"text,secret,int,float,date,time,duration" default:"text" env:"GUM_INPUT_TYPE" group:"Type"`
+	Confirm    bool         `help:"Ask for the value twice, both must match" default:"false" env:"GUM_INPUT_CONFIRM" group:"Type"`
+	Min        string       `help:"Minimum value of numbers, durations, dates and times" default:"" env:"GUM_INPUT_MIN" group:"Type"`
+	Max        string       `help:"Maximum value of numbers, durations, dates and times" default:"" env:"GUM_INPUT_MAX" group:"Type"`
+	Step       float64      `help:"Amount the up and down arrows add to numbers" default:"1" env:"GUM_INPUT_STEP" group:"Type"`
+	Format     string       `help:"Layout of dates and times, either a Go layout or a name (dateonly, timeonly, rfc3339, kitchen, ...)" default:"" env:"GUM_INPUT_FORMAT" group:"Type"`
+	WeekStart  string       `help:"First day of the week of the date picker" enum:"sunday,monday,tuesday,wednesday,thursday,friday,saturday" default:"sunday" env:"GUM_INPUT_WEEK_START" group:"Type"`
+	ErrorStyle style.Styles `embed:"" prefix:"error." set:"defaultForeground=9" envprefix:"GUM_INPUT_ERROR_"`
+
+	HistoryFile             string       `help:"File of previous entries to walk through with the up and down arrows, the submitted value is appended to it" default:"" env:"GUM_INPUT_HISTORY_FILE" group:"Completion"`
+	Suggestions             []string     `help:"Suggestions to complete the value with (tab to accept)" default:"" env:"GUM_INPUT_SUGGESTIONS" group:"Completion"`
+	SuggestionsCommand      string       `help:"Command printing suggestions, one per line, re-run as the value changes (the value is in $GUM_INPUT_VALUE)" default:"" env:"GUM_INPUT_SUGGESTIONS_COMMAND" group:"Completion"`
+	SuggestionStyle         style.Styles `embed:"" prefix:"suggestion." set:"defaultForeground=240" envprefix:"GUM_INPUT_SUGGESTION_"`
+	SelectedSuggestionStyle style.Styles `embed:"" prefix:"selected-suggestion." set:"defaultForeground=212" envprefix:"GUM_INPUT_SELECTED_SUGGESTION_"`
+
+	Editor          string `help:"Editor command opened with alt+e (defaults to $EDITOR)" default:"" env:"GUM_INPUT_EDITOR" group:"Editor"`
+	EditorExtension string `help:"Extension of the file opened in the editor, for its syntax" default:"txt" env:"GUM_INPUT_EDITOR_EXTENSION" group:"Editor"`
+	EditorSubmit    bool   `help:"Submit the value when the editor exits" default:"false" env:"GUM_INPUT_EDITOR_SUBMIT" group:"Editor"`
+
+	SuggestFn func(prefix string) []string `kong:"-"`
 }
@@ -0,0 +1,199 @@
+package input
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/gum/internal/timeformat"
+)
+
+// parser checks a typed value and returns its normalized form.
+type parser func(value string) (string, error)
+
+func parseText(value string) (string, error) { return value, nil }
+
+// bounds parses the --min and --max flags with the given function.
+func bounds[T any](lo, hi string, parse func(string) (T, error)) (*T, *T, error) {
+	var low, high *T
+	if lo != "" {
+		v, err := parse(lo)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid minimum %q: %w", lo, err)
+		}
+		low = &v
+	}
+	if hi != "" {
+		v, err := parse(hi)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid maximum %q: %w", hi, err)
+		}
+		high = &v
+	}
+	return low, high, nil
+}
+
+func parseFloat(s string) (float64, error) {
+	return strconv.ParseFloat(strings.TrimSpace(s), 64)
+}
+
+// numbers checks and steps through int or float values.
+type numbers interface {
+	// parse checks the value, and returns its normalized form.
+	parse(value string) (string, error)
+	// add steps the value up (or down for negative times), within the
+	// bounds.
+	add(value string, times int) string
+}
+
+// floats are float values.
+type floats struct {
+	low, high *float64
+	step      float64
+}
+
+func (n floats) format(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func (n floats) parse(value string) (string, error) {
+	f, err := parseFloat(value)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return "", fmt.Errorf("%q is not a number", value)
+	}
+	if n.low != nil && f < *n.low {
+		return "", fmt.Errorf("must be at least %s", n.format(*n.low))
+	}
+	if n.high != nil && f > *n.high {
+		return "", fmt.Errorf("must be at most %s", n.format(*n.high))
+	}
+	return n.format(f), nil
+}
+
+func (n floats) add(value string, times int) string {
+	f, err := parseFloat(value)
+	if err != nil {
+		f = 0
+		if n.low != nil {
+			f = *n.low
+		}
+		times = 0
+	}
+	f += float64(times) * n.step
+	if n.low != nil {
+		f = max(f, *n.low)
+	}
+	if n.high != nil {
+		f = min(f, *n.high)
+	}
+	return n.format(f)
+}
+
+// ints are int values, kept as integers so that large ones stay exact.
+type ints struct {
+	low, high *int64
+	step      int64
+}
+
+func parseInt(s string) (int64, error) {
+	return strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+}
+
+func (n ints) parse(value string) (string, error) {
+	i, err := parseInt(value)
+	if err != nil {
+		return "", fmt.Errorf("%q is not an integer", value)
+	}
+	if n.low != nil && i < *n.low {
+		return "", fmt.Errorf("must be at least %d", *n.low)
+	}
+	if n.high != nil && i > *n.high {
+		return "", fmt.Errorf("must be at most %d", *n.high)
+	}
+	return strconv.FormatInt(i, 10), nil
+}
+
+func (n ints) add(value string, times int) string {
+	i, err := parseInt(value)
+	if err != nil {
+		i = 0
+		if n.low != nil {
+			i = *n.low
+		}
+		times = 0
+	}
+	// The sum saturates rather than overflows.
+	switch d := int64(times) * n.step; {
+	case d > 0 && i > math.MaxInt64-d:
+		i = math.MaxInt64
+	case d < 0 && i < math.MinInt64-d:
+		i = math.MinInt64
+	default:
+		i += d
+	}
+	if n.low != nil {
+		i = max(i, *n.low)
+	}
+	if n.high != nil {
+		i = min(i, *n.high)
+	}
+	return strconv.FormatInt(i, 10)
+}
+
+func newNumbers(o Options) (numbers, error) {
+	if o.Type == "int" {
+		low, high, err := bounds(o.Min, o.Max, parseInt)
+		if err != nil {
+			return nil, err
+		}
+		return ints{low: low, high: high, step: max(1, int64(math.Round(o.Step)))}, nil
+	}
+	low, high, err := bounds(o.Min, o.Max, parseFloat)
+	if err != nil {
+		return nil, err
+	}
+	return floats{low: low, high: high, step: o.Step}, nil
+}
+
+func newDurationParser(o Options) (parser, error) {
+	low, high, err := bounds(o.Min, o.Max, time.ParseDuration)
+	if err != nil {
+		return nil, err
+	}
+	return func(value string) (string, error) {
+		d, err := time.ParseDuration(strings.TrimSpace(value))
+		if err != nil {
+			return "", fmt.Errorf("%q is not a duration (e.g. 1h30m)", value)
+		}
+		if low != nil && d < *low {
+			return "", fmt.Errorf("must be at least %s", low)
+		}
+		if high != nil && d > *high {
+			return "", fmt.Errorf("must be at most %s", high)
+		}
+		return d.String(), nil
+	}, nil
+}
+
+// layout returns the layout of dates or times.
+func (o Options) layout() string {
+	switch {
+	case o.Format != "":
+		return timeformat.Layout(o.Format)
+	case o.Type == "time":
+		return "15:04"
+	default:
+		return time.DateOnly
+	}
+}
+
+// parseTime parses a date or time with the layout, falling back to the
+// layouts of the input type.
+func parseTime(layout, kind, value string) (time.Time, error) {
+	if kind == "time" {
+		return timeformat.Parse(value, layout, time.TimeOnly, "15:04", time.Kitchen)
+	}
+	return timeformat.Parse(value, layout, time.DateOnly, time.RFC3339)
+}
@@ -0,0 +1,114 @@
+// Package accessible asks prompts line by line, for screen readers and dumb
+// terminals which cannot follow the interfaces redrawn in place.
+//
+// Prompts are written to stderr, and read from the terminal: stdin if it is
+// one, else /dev/tty. Closing the input aborts the prompt.
+package accessible
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/gum/internal/answer"
+	"github.com/charmbracelet/gum/internal/exit"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/term"
+)
+
+// Enabled asks the prompts line by line, as does $GUM_ACCESSIBLE or a dumb
+// terminal.
+var Enabled bool
+
+// On reports whether prompts are asked line by line, from --accessible,
+// $GUM_ACCESSIBLE or TERM=dumb.
+func On() bool {
+	if Enabled {
+		return true
+	}
+	if on, err := answer.ParseBool(os.Getenv("GUM_ACCESSIBLE")); err == nil {
+		return on
+	}
+	return os.Getenv("TERM") == "dumb"
+}
+
+// ErrAborted is returned when the input is closed.
+var ErrAborted = exit.ErrExit(exit.StatusAborted)
+
+var input = sync.OnceValues(func() (*os.File, *bufio.Reader) {
+	f := os.Stdin
+	if !term.IsTerminal(f.Fd()) {
+		if tty, err := os.Open("/dev/tty"); err == nil {
+			f = tty
+		}
+	}
+	return f, bufio.NewReader(f)
+})
+
+// Println writes a line of the prompt, without styles.
+func Println(s string) {
+	fmt.Fprintln(os.Stderr, ansi.Strip(s))
+}
+
+// ReadLine asks the prompt, and returns the line typed.
+func ReadLine(prompt string) (string, error) {
+	fmt.Fprint(os.Stderr, ansi.Strip(prompt))
+	_, r := input()
+	line, err := r.ReadString('\n')
+	if errors.Is(err, io.EOF) && line == "" {
+		fmt.Fprintln(os.Stderr)
+		return "", ErrAborted
+	}
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// ReadSecret asks the prompt, and returns the line typed without echoing
+// it, when reading from a terminal.
+func ReadSecret(prompt string) (string, error) {
+	f, _ := input()
+	if !term.IsTerminal(f.Fd()) {
+		return ReadLine(prompt)
+	}
+	fmt.Fprint(os.Stderr, ansi.Strip(prompt))
+	b, err := term.ReadPassword(f.Fd())
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// Item returns the line of the nth item of a list, from 1.
+func Item(n int, text string) string {
+	return fmt.Sprintf("%d. %s", n, text)
+}
+
+// ParseNumbers parses the numbers of items of a list of n, separated by
+// spaces or commas, and returns their indices from 0 in the order given.
+// At most limit numbers are accepted, repeated ones are ignored.
+func ParseNumbers(line string, n, limit int) ([]int, error) {
+	var indices []int
+	seen := map[int]bool{}
+	for _, field := range strings.FieldsFunc(line, func(r rune) bool { return r == ',' || r == ' ' || r == '\t' }) {
+		i, err := strconv.Atoi(field)
+		if err != nil || i < 1 || i > n {
+			return nil, fmt.Errorf("%q is not a number from 1 to %d", field, n)
+		}
+		if !seen[i] {
+			seen[i] = true
+			indices = append(indices, i-1)
+		}
+	}
+	if len(indices) > limit {
+		return nil, fmt.Errorf("expected at most %d numbers, got %d", limit, len(indices))
+	}
+	return indices, nil
+}
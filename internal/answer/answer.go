@@ -0,0 +1,158 @@
+// Package answer answers prompts without opening their interface, for
+// scripts running without a terminal such as in CI.
+//
+// The answers file is a JSON document mapping the id of each prompt, or its
+// header when it has no id, to its answer. Several choices are answered with
+// an array:
+//
+//	{
+//	  "Deploy to production?": "yes",
+//	  "env": "staging",
+//	  "targets": ["web", "db"]
+//	}
+//
+// With $GUM_ASSUME_YES or --yes, confirmations are answered affirmatively and
+// the other prompts left unanswered take their default value.
+//
+// Sessions are recorded to the file $GUM_RECORD, and replayed from the file
+// $GUM_REPLAY, see [Record].
+package answer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var (
+	// AssumeYes answers confirmations affirmatively and the other prompts
+	// with their default value, as does $GUM_ASSUME_YES.
+	AssumeYes bool
+
+	// File is the path of the answers file, overriding $GUM_ANSWERS.
+	File string
+)
+
+// ParseBool parses yes, y, true, 1 and no, n, false, 0, ignoring case and
+// surrounding spaces.
+func ParseBool(s string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "yes", "y", "true", "1":
+		return true, nil
+	case "no", "n", "false", "0":
+		return false, nil
+	}
+	return false, fmt.Errorf("%q is neither yes nor no", s)
+}
+
+// Yes reports whether prompts are answered affirmatively, from --yes or
+// $GUM_ASSUME_YES.
+func Yes() bool {
+	if AssumeYes {
+		return true
+	}
+	yes, _ := ParseBool(os.Getenv("GUM_ASSUME_YES"))
+	return yes
+}
+
+// Key returns the key of a prompt in the answers file: its id, or its header.
+func Key(id, header string) string {
+	if id != "" {
+		return id
+	}
+	return strings.TrimSpace(header)
+}
+
+var (
+	mu    sync.Mutex
+	files = map[string]map[string]json.RawMessage{}
+)
+
+// Lookup returns the answers of the prompt, replayed from $GUM_REPLAY or
+// else from the answers file.
+func Lookup(p Prompt) ([]string, bool, error) {
+	if path := os.Getenv("GUM_REPLAY"); path != "" {
+		answers, err := replay(path, p)
+		return answers, err == nil, err
+	}
+
+	key := p.ID
+	path := File
+	if path == "" {
+		path = os.Getenv("GUM_ANSWERS")
+	}
+	if path == "" || key == "" {
+		return nil, false, nil
+	}
+	answers, err := load(path)
+	if err != nil {
+		return nil, false, err
+	}
+	raw, ok := answers[key]
+	if !ok || string(raw) == "null" {
+		return nil, false, nil
+	}
+
+	var values []string
+	if raw[0] == '[' {
+		var list []json.RawMessage
+		if err := json.Unmarshal(raw, &list); err != nil {
+			return nil, false, fmt.Errorf("invalid answer %q: %w", key, err)
+		}
+		for _, item := range list {
+			value, err := scalar(item)
+			if err != nil {
+				return nil, false, fmt.Errorf("invalid answer %q: %w", key, err)
+			}
+			values = append(values, value)
+		}
+		return values, true, nil
+	}
+	value, err := scalar(raw)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid answer %q: %w", key, err)
+	}
+	return []string{value}, true, nil
+}
+
+// load reads the answers file once.
+func load(path string) (map[string]json.RawMessage, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	if answers, ok := files[path]; ok {
+		return answers, nil
+	}
+	bts, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read answers: %w", err)
+	}
+	answers := map[string]json.RawMessage{}
+	if err := json.Unmarshal(bts, &answers); err != nil {
+		return nil, fmt.Errorf("invalid answers %s: %w", path, err)
+	}
+	files[path] = answers
+	return answers, nil
+}
+
+// scalar returns a string, number or boolean answer as a string.
+func scalar(raw json.RawMessage) (string, error) {
+	d := json.NewDecoder(bytes.NewReader(raw))
+	d.UseNumber()
+	var v any
+	if err := d.Decode(&v); err != nil {
+		return "", err
+	}
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	}
+	return "", fmt.Errorf("expected a string, number or boolean, got %s", raw)
+}
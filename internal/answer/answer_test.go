@@ -0,0 +1,133 @@
+package answer
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseBool(t *testing.T) {
+	for in, tt := range map[string]struct {
+		out bool
+		err bool
+	}{
+		"yes":   {out: true},
+		"Y":     {out: true},
+		" TRUE": {out: true},
+		"1":     {out: true},
+		"no":    {out: false},
+		"N ":    {out: false},
+		"False": {out: false},
+		"0":     {out: false},
+		"":      {err: true},
+		"yep":   {err: true},
+		"2":     {err: true},
+	} {
+		t.Run(in, func(t *testing.T) {
+			out, err := ParseBool(in)
+			if (err != nil) != tt.err {
+				t.Fatalf("expected error %v, got %v", tt.err, err)
+			}
+			if out != tt.out {
+				t.Errorf("expected %v, got %v", tt.out, out)
+			}
+		})
+	}
+}
+
+func TestYes(t *testing.T) {
+	for env, out := range map[string]bool{"": false, "1": true, "yes": true, "no": false, "maybe": false} {
+		t.Run(env, func(t *testing.T) {
+			t.Setenv("GUM_ASSUME_YES", env)
+			if got := Yes(); got != out {
+				t.Errorf("expected %v, got %v", out, got)
+			}
+		})
+	}
+}
+
+func TestKey(t *testing.T) {
+	if got := Key("env", "Pick an environment"); got != "env" {
+		t.Errorf("expected the id, got %q", got)
+	}
+	if got := Key("", "  Deploy?\n"); got != "Deploy?" {
+		t.Errorf("expected the trimmed header, got %q", got)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "answers.json")
+	data := `{
+		"Deploy?": "yes",
+		"env": "staging",
+		"targets": ["web", "db"],
+		"count": 3,
+		"force": false,
+		"none": null,
+		"empty": [],
+		"nested": {"a": 1},
+		"mixed": ["web", {"a": 1}]
+	}`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("GUM_ANSWERS", path)
+	t.Setenv("GUM_REPLAY", "")
+
+	for name, tt := range map[string]struct {
+		id  string
+		out []string
+		ok  bool
+		err string
+	}{
+		"header":         {id: "Deploy?", out: []string{"yes"}, ok: true},
+		"id":             {id: "env", out: []string{"staging"}, ok: true},
+		"several":        {id: "targets", out: []string{"web", "db"}, ok: true},
+		"number":         {id: "count", out: []string{"3"}, ok: true},
+		"boolean":        {id: "force", out: []string{"false"}, ok: true},
+		"null":           {id: "none"},
+		"missing":        {id: "other"},
+		"no key":         {id: ""},
+		"empty":          {id: "empty", out: nil, ok: true},
+		"object":         {id: "nested", err: `invalid answer "nested"`},
+		"object in list": {id: "mixed", err: `invalid answer "mixed"`},
+	} {
+		t.Run(name, func(t *testing.T) {
+			out, ok, err := Lookup(Prompt{Command: "choose", ID: tt.id})
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("expected error %q, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ok != tt.ok || !slices.Equal(out, tt.out) {
+				t.Errorf("expected %q (%v), got %q (%v)", tt.out, tt.ok, out, ok)
+			}
+		})
+	}
+}
+
+func TestLookupFlag(t *testing.T) {
+	dir := t.TempDir()
+	env := filepath.Join(dir, "env.json")
+	flag := filepath.Join(dir, "flag.json")
+	for path, data := range map[string]string{env: `{"env": "dev"}`, flag: `{"env": "prod"}`} {
+		if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Setenv("GUM_ANSWERS", env)
+	t.Setenv("GUM_REPLAY", "")
+	File = flag
+	t.Cleanup(func() { File = "" })
+
+	out, ok, err := Lookup(Prompt{ID: "env"})
+	if err != nil || !ok || !slices.Equal(out, []string{"prod"}) {
+		t.Errorf("expected --answers to take precedence, got %q (%v, %v)", out, ok, err)
+	}
+}
@@ -0,0 +1,124 @@
+package answer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Prompt is a prompt and its answer.
+type Prompt struct {
+	// Command is the gum command prompting, such as "choose".
+	Command string `json:"command"`
+	// ID is the id of the prompt, or its header, see [Key].
+	ID string `json:"id"`
+	// Options are the options to pick from, if any.
+	Options []string `json:"options,omitempty"`
+	// Answer is what was picked or typed.
+	Answer []string `json:"answer"`
+}
+
+// Record appends the answered prompt to the file $GUM_RECORD, if set, as a
+// line of JSON.
+//
+// Replaying the file with $GUM_REPLAY answers the same prompts in the same
+// order. The position in the file is kept between the gum commands of a
+// script in the file $GUM_REPLAY_POS, or else FILE.pos, which is never
+// removed: a replay starts from the first prompt only when the position is
+// missing or empty, and fails on any prompt that differs or was not recorded.
+func Record(p Prompt) error {
+	path := os.Getenv("GUM_RECORD")
+	if path == "" {
+		return nil
+	}
+	if p.Answer == nil {
+		p.Answer = []string{}
+	}
+	bts, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600) //nolint:gosec
+	if err != nil {
+		return fmt.Errorf("unable to record: %w", err)
+	}
+	_, err = f.Write(append(bts, '\n'))
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("unable to record: %w", err)
+	}
+	return nil
+}
+
+// replay returns the answers of the next prompt of the recording, which must
+// be the same prompt.
+func replay(path string, p Prompt) ([]string, error) {
+	state := os.Getenv("GUM_REPLAY_POS")
+	if state == "" {
+		state = path + ".pos"
+	}
+	fail := func(err error) ([]string, error) {
+		return nil, fmt.Errorf("replay %s: %w", path, err)
+	}
+	prompts, err := readPrompts(path)
+	if err != nil {
+		return fail(err)
+	}
+	pos := 0
+	if bts, err := os.ReadFile(state); err == nil && len(bytes.TrimSpace(bts)) > 0 {
+		if pos, err = strconv.Atoi(strings.TrimSpace(string(bts))); err != nil {
+			return fail(fmt.Errorf("invalid position in %s: %w", state, err))
+		}
+	}
+	if pos >= len(prompts) {
+		return fail(fmt.Errorf("prompt %d (%s %q) was not recorded, remove %s to replay from the start", pos+1, p.Command, p.ID, state))
+	}
+	want := prompts[pos]
+	if !want.same(p) {
+		return fail(fmt.Errorf("prompt %d is %s %q with options %q, but %s %q with options %q was recorded",
+			pos+1, p.Command, p.ID, p.Options, want.Command, want.ID, want.Options))
+	}
+
+	if err := os.WriteFile(state, []byte(strconv.Itoa(pos+1)+"\n"), 0o600); err != nil { //nolint:gosec
+		return fail(err)
+	}
+	return want.Answer, nil
+}
+
+// same reports whether the prompts ask the same, whatever their answers.
+func (p Prompt) same(q Prompt) bool {
+	return p.Command == q.Command && p.ID == q.ID && slices.Equal(p.Options, q.Options)
+}
+
+// readPrompts reads the recorded prompts.
+func readPrompts(path string) ([]Prompt, error) {
+	bts, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var prompts []Prompt
+	s := bufio.NewScanner(bytes.NewReader(bts))
+	s.Buffer(nil, len(bts)+1)
+	for line := 1; s.Scan(); line++ {
+		if len(bytes.TrimSpace(s.Bytes())) == 0 {
+			continue
+		}
+		var p Prompt
+		if err := json.Unmarshal(s.Bytes(), &p); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		prompts = append(prompts, p)
+	}
+	if len(prompts) == 0 {
+		return nil, errors.New("no prompts were recorded")
+	}
+	return prompts, nil
+}
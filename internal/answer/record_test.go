@@ -0,0 +1,136 @@
+package answer
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestReplay(t *testing.T) {
+	a := Prompt{Command: "confirm", ID: "Deploy?", Options: []string{"Yes", "No"}, Answer: []string{"Yes"}}
+	b := Prompt{Command: "choose", ID: "env", Options: []string{"dev", "prod"}, Answer: []string{"prod"}}
+	c := Prompt{Command: "input", ID: "name", Answer: []string{"gum"}}
+
+	for name, tt := range map[string]struct {
+		recorded []Prompt
+		asked    []Prompt
+		answers  [][]string
+		err      string
+	}{
+		"same prompts": {
+			recorded: []Prompt{a, b, c},
+			asked:    []Prompt{a, b, c},
+			answers:  [][]string{{"Yes"}, {"prod"}, {"gum"}},
+		},
+		"same prompt twice": {
+			recorded: []Prompt{a, a},
+			asked:    []Prompt{a, a},
+			answers:  [][]string{{"Yes"}, {"Yes"}},
+		},
+		"different prompt": {
+			recorded: []Prompt{a, b},
+			asked:    []Prompt{a, c},
+			answers:  [][]string{{"Yes"}},
+			err:      `prompt 2 is input "name" with options [], but choose "env" with options ["dev" "prod"] was recorded`,
+		},
+		"first prompt asked again": {
+			recorded: []Prompt{a, b, a},
+			asked:    []Prompt{a, a},
+			answers:  [][]string{{"Yes"}},
+			err:      `prompt 2 is confirm "Deploy?"`,
+		},
+		"different options": {
+			recorded: []Prompt{b},
+			asked:    []Prompt{{Command: "choose", ID: "env", Options: []string{"dev"}}},
+			err:      `prompt 1 is choose "env" with options ["dev"]`,
+		},
+		"more prompts than recorded": {
+			recorded: []Prompt{a},
+			asked:    []Prompt{a, b},
+			answers:  [][]string{{"Yes"}},
+			err:      `prompt 2 (choose "env") was not recorded`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, "session.jsonl")
+			t.Setenv("GUM_RECORD", path)
+			for _, p := range tt.recorded {
+				if err := Record(p); err != nil {
+					t.Fatal(err)
+				}
+			}
+			t.Setenv("GUM_RECORD", "")
+			t.Setenv("GUM_REPLAY", path)
+			t.Setenv("GUM_REPLAY_POS", "")
+
+			var answers [][]string
+			var err error
+			for _, p := range tt.asked {
+				p.Answer = nil
+				var got []string
+				if got, _, err = Lookup(p); err != nil {
+					break
+				}
+				answers = append(answers, got)
+			}
+			if !slices.EqualFunc(answers, tt.answers, slices.Equal) {
+				t.Errorf("expected answers %q, got %q", tt.answers, answers)
+			}
+			if tt.err == "" && err != nil || tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)) {
+				t.Errorf("expected error %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestReplayStoppedEarly(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "session.jsonl")
+	pos := filepath.Join(dir, "pos")
+	a := Prompt{Command: "confirm", ID: "a", Answer: []string{"Yes"}}
+	b := Prompt{Command: "confirm", ID: "b", Answer: []string{"No"}}
+	t.Setenv("GUM_RECORD", path)
+	for _, p := range []Prompt{a, b} {
+		if err := Record(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Setenv("GUM_RECORD", "")
+	t.Setenv("GUM_REPLAY", path)
+	t.Setenv("GUM_REPLAY_POS", pos)
+
+	if _, _, err := Lookup(a); err != nil {
+		t.Fatal(err)
+	}
+	// The script is run again without answering b.
+	for range 2 {
+		if _, _, err := Lookup(a); err == nil {
+			t.Error("expected the replay to fail when the first prompt is asked again")
+		}
+	}
+	// Starting over is explicit.
+	if err := os.WriteFile(pos, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if got, _, err := Lookup(a); err != nil || !slices.Equal(got, a.Answer) {
+		t.Errorf("expected %q, got %q (%v)", a.Answer, got, err)
+	}
+}
+
+func TestRecordEmptyAnswer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.jsonl")
+	t.Setenv("GUM_RECORD", path)
+	if err := Record(Prompt{Command: "input", ID: "secret"}); err != nil {
+		t.Fatal(err)
+	}
+	bts, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(bts), `{"command":"input","id":"secret","answer":[]}`+"\n"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,216 @@
+// Package bindings remaps the key bindings of the components.
+//
+// Bindings are named after their action in kebab case, such as "down",
+// "toggle-all" or "open-in-editor", and are given keys in the "keymap"
+// section of the configuration file, for every component or under the name
+// of one of them, then with the --keymap flag of each command:
+//
+//	{
+//	  "keymap": { "down": ["down", "ctrl+j"], "up": ["up", "ctrl+k"] },
+//	  "write": { "keymap": { "submit": "ctrl+d" } }
+//	}
+//
+//	$ gum choose --keymap "toggle=space,x;submit=enter,ctrl+s" a b c
+//
+// Keys are separated by commas, "space" is the space bar, and an empty list
+// unbinds the action.
+package bindings
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/gum/internal/config"
+)
+
+// keys are the keys of a binding, as a list or a comma-separated string.
+type keys []string
+
+// UnmarshalJSON reads keys from a string or an array of strings.
+func (k *keys) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*k = split(s)
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return fmt.Errorf("expected keys, got %s", data)
+	}
+	*k = nil
+	for _, s := range list {
+		*k = append(*k, name(s))
+	}
+	return nil
+}
+
+// split splits comma-separated keys.
+func split(s string) []string {
+	var list []string
+	for _, s := range strings.Split(s, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			list = append(list, name(s))
+		}
+	}
+	return list
+}
+
+// name returns the name bubbletea gives to the key.
+func name(s string) string {
+	if s == "space" {
+		return " "
+	}
+	return s
+}
+
+// display returns the name of the key shown in the help.
+func display(s string) string {
+	if s == " " {
+		return "space"
+	}
+	return s
+}
+
+// Remap remaps the bindings of the keymaps, pointers to structs of
+// key.Binding fields, from the configuration file and then from the given
+// overrides. Remapped bindings show their first key in the help.
+//
+// Actions of the global section that the component does not have are
+// ignored, while the others are errors.
+func Remap(component string, overrides map[string]string, keymaps ...any) error {
+	byAction := map[string][]*key.Binding{}
+	var actions []string
+	for _, km := range keymaps {
+		collect(reflect.ValueOf(km).Elem(), byAction, &actions)
+	}
+
+	var global map[string]keys
+	if err := config.Load("keymap", &global); err != nil {
+		return err
+	}
+	var section struct {
+		Keymap map[string]keys `json:"keymap"`
+	}
+	if err := config.Load(component, &section); err != nil {
+		return err
+	}
+
+	for action, k := range global {
+		remap(byAction[normalize(action)], k)
+	}
+	for action, k := range section.Keymap {
+		if err := remapAction(component, byAction, actions, action, k); err != nil {
+			return err
+		}
+	}
+	for action, k := range overrides {
+		if err := remapAction(component, byAction, actions, action, split(k)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func remapAction(component string, byAction map[string][]*key.Binding, actions []string, action string, k []string) error {
+	bindings, ok := byAction[normalize(action)]
+	if !ok {
+		return fmt.Errorf("unknown key binding %q for %s, expected one of: %s", action, component, strings.Join(actions, ", "))
+	}
+	remap(bindings, k)
+	return nil
+}
+
+// remap sets the keys of the bindings, and the first one as their help.
+func remap(bindings []*key.Binding, k []string) {
+	for _, b := range bindings {
+		b.SetKeys(k...)
+		if help := b.Help(); help.Key != "" && len(k) > 0 {
+			b.SetHelp(display(k[0]), help.Desc)
+		}
+	}
+}
+
+// collect gathers the key.Binding fields of the struct by action, following
+// embedded structs.
+func collect(v reflect.Value, byAction map[string][]*key.Binding, actions *[]string) {
+	t := v.Type()
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if b, ok := v.Field(i).Addr().Interface().(*key.Binding); ok {
+			action := kebab(field.Name)
+			if !slices.Contains(*actions, action) {
+				*actions = append(*actions, action)
+			}
+			byAction[normalize(action)] = append(byAction[normalize(action)], b)
+			continue
+		}
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			collect(v.Field(i), byAction, actions)
+		}
+	}
+}
+
+// kebab returns the name of the action of a field, e.g. toggle-all for
+// ToggleAll.
+func kebab(field string) string {
+	runes := []rune(field)
+	var b strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) &&
+			(unicode.IsLower(runes[i-1]) || i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+			b.WriteByte('-')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// normalize lets actions be given in any case, with or without dashes.
+func normalize(action string) string {
+	return strings.NewReplacer("-", "", "_", "").Replace(strings.ToLower(action))
+}
+
+var arrows = map[string]string{
+	"left":  "←",
+	"down":  "↓",
+	"up":    "↑",
+	"right": "→",
+}
+
+// Help returns a help entry for the bindings together, such as "↓↑
+// navigate". Each binding is shown with its arrow key, or else with its
+// first key, so that the entry follows remapped bindings.
+func Help(desc string, bindings ...key.Binding) key.Binding {
+	var all, shown []string
+	enabled, onlyArrows := false, true
+	for _, b := range bindings {
+		k := b.Keys()
+		if len(k) == 0 {
+			continue
+		}
+		all = append(all, k...)
+		enabled = enabled || b.Enabled()
+		i := slices.IndexFunc(k, func(s string) bool { return arrows[s] != "" })
+		if i < 0 {
+			onlyArrows = false
+			shown = append(shown, display(k[0]))
+			continue
+		}
+		shown = append(shown, arrows[k[i]])
+	}
+	sep := ""
+	if !onlyArrows {
+		sep = "/"
+	}
+	b := key.NewBinding(key.WithKeys(all...), key.WithHelp(strings.Join(shown, sep), desc))
+	b.SetEnabled(enabled)
+	return b
+}
@@ -0,0 +1,244 @@
+package bindings
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/key"
+)
+
+// configFile is read once, so every test shares it.
+const configFile = `{
+	"keymap": { "down": ["down", "ctrl+j"], "unknown": "ctrl+u" },
+	"good": { "keymap": { "submit": "ctrl+s", "ToggleAll": "A" } },
+	"bad": { "keymap": { "nope": "n" } }
+}`
+
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "bindings")
+	if err != nil {
+		panic(err)
+	}
+	path := filepath.Join(dir, "config.json")
+	if err := os.WriteFile(path, []byte(configFile), 0o600); err != nil {
+		panic(err)
+	}
+	if err := os.Setenv("GUM_CONFIG", path); err != nil {
+		panic(err)
+	}
+	code := m.Run()
+	_ = os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+// Inner is exported, as are the keymaps embedded from bubbles.
+type Inner struct {
+	PageDown key.Binding
+}
+
+type testKeymap struct {
+	Inner
+	Down      key.Binding
+	Up        key.Binding
+	ToggleAll key.Binding
+	Submit    key.Binding
+}
+
+func newTestKeymap() testKeymap {
+	return testKeymap{
+		Inner: Inner{PageDown: key.NewBinding(key.WithKeys("pgdown"))},
+		Down:  key.NewBinding(key.WithKeys("down")),
+		Up:    key.NewBinding(key.WithKeys("up")),
+		ToggleAll: key.NewBinding(
+			key.WithKeys("ctrl+a"),
+			key.WithHelp("ctrl+a", "select all"),
+		),
+		Submit: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "submit"),
+		),
+	}
+}
+
+func TestRemap(t *testing.T) {
+	type want struct {
+		keys []string
+		help string
+	}
+	for name, tt := range map[string]struct {
+		component string
+		overrides map[string]string
+		field     func(testKeymap) key.Binding
+		want      want
+		err       string
+	}{
+		"default": {
+			field: func(k testKeymap) key.Binding { return k.Submit },
+			want:  want{keys: []string{"enter"}, help: "enter"},
+		},
+		"global section": {
+			field: func(k testKeymap) key.Binding { return k.Down },
+			want:  want{keys: []string{"down", "ctrl+j"}},
+		},
+		"component section": {
+			component: "good",
+			field:     func(k testKeymap) key.Binding { return k.Submit },
+			want:      want{keys: []string{"ctrl+s"}, help: "ctrl+s"},
+		},
+		"component section in any case": {
+			component: "good",
+			field:     func(k testKeymap) key.Binding { return k.ToggleAll },
+			want:      want{keys: []string{"A"}, help: "A"},
+		},
+		"unknown action in component section": {
+			component: "bad",
+			err:       `unknown key binding "nope" for bad, expected one of: page-down, down, up, toggle-all, submit`,
+		},
+		"override": {
+			overrides: map[string]string{"submit": "ctrl+s, enter"},
+			field:     func(k testKeymap) key.Binding { return k.Submit },
+			want:      want{keys: []string{"ctrl+s", "enter"}, help: "ctrl+s"},
+		},
+		"override over the sections": {
+			component: "good",
+			overrides: map[string]string{"submit": "ctrl+d"},
+			field:     func(k testKeymap) key.Binding { return k.Submit },
+			want:      want{keys: []string{"ctrl+d"}, help: "ctrl+d"},
+		},
+		"override in kebab case": {
+			overrides: map[string]string{"toggle-all": "x"},
+			field:     func(k testKeymap) key.Binding { return k.ToggleAll },
+			want:      want{keys: []string{"x"}, help: "x"},
+		},
+		"override in snake case": {
+			overrides: map[string]string{"toggle_all": "x"},
+			field:     func(k testKeymap) key.Binding { return k.ToggleAll },
+			want:      want{keys: []string{"x"}, help: "x"},
+		},
+		"embedded struct": {
+			overrides: map[string]string{"page-down": "ctrl+f"},
+			field:     func(k testKeymap) key.Binding { return k.PageDown },
+			want:      want{keys: []string{"ctrl+f"}},
+		},
+		"space": {
+			overrides: map[string]string{"toggle-all": "space"},
+			field:     func(k testKeymap) key.Binding { return k.ToggleAll },
+			want:      want{keys: []string{" "}, help: "space"},
+		},
+		"unbind": {
+			overrides: map[string]string{"submit": ""},
+			field:     func(k testKeymap) key.Binding { return k.Submit },
+			want:      want{keys: nil, help: "enter"},
+		},
+		"unknown override": {
+			overrides: map[string]string{"nope": "n"},
+			err:       `unknown key binding "nope" for test, expected one of: page-down, down, up, toggle-all, submit`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			component := tt.component
+			if component == "" {
+				component = "test"
+			}
+			km := newTestKeymap()
+			err := Remap(component, tt.overrides, &km)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("expected error %q, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			b := tt.field(km)
+			if !slices.Equal(b.Keys(), tt.want.keys) {
+				t.Errorf("expected keys %q, got %q", tt.want.keys, b.Keys())
+			}
+			if b.Help().Key != tt.want.help {
+				t.Errorf("expected help %q, got %q", tt.want.help, b.Help().Key)
+			}
+		})
+	}
+}
+
+func TestRemapSeveralKeymaps(t *testing.T) {
+	a, b := newTestKeymap(), newTestKeymap()
+	if err := Remap("test", map[string]string{"up": "k"}, &a, &b); err != nil {
+		t.Fatal(err)
+	}
+	for _, km := range []testKeymap{a, b} {
+		if !slices.Equal(km.Up.Keys(), []string{"k"}) {
+			t.Errorf("expected both keymaps to be remapped, got %q", km.Up.Keys())
+		}
+	}
+}
+
+func TestHelp(t *testing.T) {
+	for name, tt := range map[string]struct {
+		overrides map[string]string
+		out       string
+	}{
+		"arrows":            {out: "↓↑ navigate"},
+		"arrow kept":        {overrides: map[string]string{"down": "j,down"}, out: "↓↑ navigate"},
+		"remapped":          {overrides: map[string]string{"down": "j", "up": "k"}, out: "j/k navigate"},
+		"one remapped":      {overrides: map[string]string{"down": "ctrl+n"}, out: "ctrl+n/↑ navigate"},
+		"space":             {overrides: map[string]string{"down": "space"}, out: "space/↑ navigate"},
+		"one unbound":       {overrides: map[string]string{"down": ""}, out: "↑ navigate"},
+		"arrows from other": {overrides: map[string]string{"down": "up", "up": "down"}, out: "↑↓ navigate"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			km := newTestKeymap()
+			if err := Remap("test", tt.overrides, &km); err != nil {
+				t.Fatal(err)
+			}
+			h := Help("navigate", km.Down, km.Up).Help()
+			if got := h.Key + " " + h.Desc; got != tt.out {
+				t.Errorf("expected %q, got %q", tt.out, got)
+			}
+		})
+	}
+}
+
+func TestKebab(t *testing.T) {
+	for in, out := range map[string]string{
+		"Down":           "down",
+		"ToggleAll":      "toggle-all",
+		"OpenInEditor":   "open-in-editor",
+		"GoToTop":        "go-to-top",
+		"HTMLPreview":    "html-preview",
+		"LineEnd":        "line-end",
+		"DeleteWordLeft": "delete-word-left",
+	} {
+		if got := kebab(in); got != out {
+			t.Errorf("expected %q for %s, got %q", out, in, got)
+		}
+	}
+}
+
+func TestKeys(t *testing.T) {
+	for in, out := range map[string][]string{
+		`"ctrl+j"`:            {"ctrl+j"},
+		`"ctrl+j, j"`:         {"ctrl+j", "j"},
+		`"space,x"`:           {" ", "x"},
+		`["space", "ctrl+a"]`: {" ", "ctrl+a"},
+		`""`:                  nil,
+		`[]`:                  nil,
+	} {
+		var k keys
+		if err := json.Unmarshal([]byte(in), &k); err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(k, out) {
+			t.Errorf("expected %q for %s, got %q", out, in, k)
+		}
+	}
+	var k keys
+	if err := json.Unmarshal([]byte(`1`), &k); err == nil || !strings.Contains(err.Error(), "expected keys") {
+		t.Errorf("expected an error for a number, got %v", err)
+	}
+}
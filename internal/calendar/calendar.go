@@ -0,0 +1,232 @@
+// Package calendar provides a month-grid date picker and a time-of-day
+// picker.
+package calendar
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// KeyMap is the key bindings of the date picker.
+type KeyMap struct {
+	PrevDay   key.Binding
+	NextDay   key.Binding
+	PrevWeek  key.Binding
+	NextWeek  key.Binding
+	PrevMonth key.Binding
+	NextMonth key.Binding
+	PrevYear  key.Binding
+	NextYear  key.Binding
+	Today     key.Binding
+}
+
+// DefaultKeyMap returns the default key bindings of the date picker.
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		PrevDay:   key.NewBinding(key.WithKeys("left", "h"), key.WithHelp("←→", "day")),
+		NextDay:   key.NewBinding(key.WithKeys("right", "l")),
+		PrevWeek:  key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑↓", "week")),
+		NextWeek:  key.NewBinding(key.WithKeys("down", "j")),
+		PrevMonth: key.NewBinding(key.WithKeys("pgup", "["), key.WithHelp("[]", "month")),
+		NextMonth: key.NewBinding(key.WithKeys("pgdown", "]")),
+		PrevYear:  key.NewBinding(key.WithKeys("{"), key.WithHelp("{}", "year")),
+		NextYear:  key.NewBinding(key.WithKeys("}")),
+		Today:     key.NewBinding(key.WithKeys("t"), key.WithHelp("t", "today")),
+	}
+}
+
+// Styles is the styles of the date picker.
+type Styles struct {
+	Header   lipgloss.Style
+	Weekday  lipgloss.Style
+	Day      lipgloss.Style
+	Today    lipgloss.Style
+	Cursor   lipgloss.Style
+	Range    lipgloss.Style
+	Disabled lipgloss.Style
+}
+
+// DefaultStyles returns the default styles of the date picker.
+func DefaultStyles() Styles {
+	return Styles{
+		Header:   lipgloss.NewStyle().Bold(true),
+		Weekday:  lipgloss.NewStyle().Foreground(lipgloss.Color("240")),
+		Day:      lipgloss.NewStyle(),
+		Today:    lipgloss.NewStyle().Underline(true),
+		Cursor:   lipgloss.NewStyle().Foreground(lipgloss.Color("230")).Background(lipgloss.Color("212")),
+		Range:    lipgloss.NewStyle().Foreground(lipgloss.Color("212")),
+		Disabled: lipgloss.NewStyle().Foreground(lipgloss.Color("238")),
+	}
+}
+
+// Model is a month-grid date picker.
+type Model struct {
+	// Min and Max bound the dates that can be picked. Zero values leave the
+	// bound open.
+	Min, Max time.Time
+	// WeekStart is the first day of the week.
+	WeekStart time.Weekday
+	// Anchor is the other end of the range being picked, if any. The days
+	// between it and the cursor are highlighted.
+	Anchor time.Time
+
+	KeyMap KeyMap
+	Styles Styles
+
+	cursor time.Time
+}
+
+// New returns a date picker on the given date.
+func New(t time.Time) Model {
+	return Model{
+		KeyMap: DefaultKeyMap(),
+		Styles: DefaultStyles(),
+		cursor: Day(t),
+	}
+}
+
+// Day truncates t to midnight.
+func Day(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// Cursor returns the date under the cursor.
+func (m Model) Cursor() time.Time { return m.cursor }
+
+// SetCursor moves the cursor to the given date, within the bounds.
+func (m *Model) SetCursor(t time.Time) {
+	t = Day(t)
+	if !m.Min.IsZero() && t.Before(Day(m.Min)) {
+		t = Day(m.Min)
+	}
+	if !m.Max.IsZero() && t.After(Day(m.Max)) {
+		t = Day(m.Max)
+	}
+	m.cursor = t
+}
+
+// Enabled reports whether the date is within the bounds.
+func (m Model) Enabled(t time.Time) bool {
+	t = Day(t)
+	return (m.Min.IsZero() || !t.Before(Day(m.Min))) &&
+		(m.Max.IsZero() || !t.After(Day(m.Max)))
+}
+
+// addMonths adds months to t, keeping the day within the target month.
+func addMonths(t time.Time, months int) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(months), 1, 0, 0, 0, 0, t.Location())
+	last := first.AddDate(0, 1, -1).Day()
+	return first.AddDate(0, 0, min(t.Day(), last)-1)
+}
+
+// Update moves the cursor.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+	switch {
+	case key.Matches(keyMsg, m.KeyMap.PrevDay):
+		m.SetCursor(m.cursor.AddDate(0, 0, -1))
+	case key.Matches(keyMsg, m.KeyMap.NextDay):
+		m.SetCursor(m.cursor.AddDate(0, 0, 1))
+	case key.Matches(keyMsg, m.KeyMap.PrevWeek):
+		m.SetCursor(m.cursor.AddDate(0, 0, -7))
+	case key.Matches(keyMsg, m.KeyMap.NextWeek):
+		m.SetCursor(m.cursor.AddDate(0, 0, 7))
+	case key.Matches(keyMsg, m.KeyMap.PrevMonth):
+		m.SetCursor(addMonths(m.cursor, -1))
+	case key.Matches(keyMsg, m.KeyMap.NextMonth):
+		m.SetCursor(addMonths(m.cursor, 1))
+	case key.Matches(keyMsg, m.KeyMap.PrevYear):
+		m.SetCursor(addMonths(m.cursor, -12))
+	case key.Matches(keyMsg, m.KeyMap.NextYear):
+		m.SetCursor(addMonths(m.cursor, 12))
+	case key.Matches(keyMsg, m.KeyMap.Today):
+		m.SetCursor(time.Now().In(m.cursor.Location()))
+	}
+	return m, nil
+}
+
+// View renders the month of the cursor.
+func (m Model) View() string {
+	const width = 7*3 - 1
+
+	var b strings.Builder
+	title := fmt.Sprintf("%s %d", m.cursor.Month(), m.cursor.Year())
+	b.WriteString(m.Styles.Header.Render(lipgloss.PlaceHorizontal(width, lipgloss.Center, title)))
+	b.WriteByte('\n')
+
+	names := make([]string, 7)
+	for i := range names {
+		names[i] = time.Weekday((int(m.WeekStart) + i) % 7).String()[:2]
+	}
+	b.WriteString(m.Styles.Weekday.Render(strings.Join(names, " ")))
+
+	today := Day(time.Now().In(m.cursor.Location()))
+	first := time.Date(m.cursor.Year(), m.cursor.Month(), 1, 0, 0, 0, 0, m.cursor.Location())
+	offset := (int(first.Weekday()) - int(m.WeekStart) + 7) % 7
+	day := first.AddDate(0, 0, -offset)
+	for day.Month() == m.cursor.Month() || day.Before(first) {
+		b.WriteByte('\n')
+		cells := make([]string, 7)
+		for i := range cells {
+			cells[i] = "  "
+			if day.Month() == m.cursor.Month() {
+				cells[i] = m.dayStyle(day, today).Render(fmt.Sprintf("%2d", day.Day()))
+			}
+			day = day.AddDate(0, 0, 1)
+		}
+		b.WriteString(strings.Join(cells, " "))
+	}
+	return b.String()
+}
+
+func (m Model) dayStyle(day, today time.Time) lipgloss.Style {
+	switch {
+	case day.Equal(m.cursor):
+		return m.Styles.Cursor
+	case !m.Enabled(day):
+		return m.Styles.Disabled
+	case m.inRange(day):
+		return m.Styles.Range
+	case day.Equal(today):
+		return m.Styles.Today
+	default:
+		return m.Styles.Day
+	}
+}
+
+func (m Model) inRange(day time.Time) bool {
+	if m.Anchor.IsZero() {
+		return false
+	}
+	start, end := Day(m.Anchor), m.cursor
+	if end.Before(start) {
+		start, end = end, start
+	}
+	return !day.Before(start) && !day.After(end)
+}
+
+// ShortHelp implements help.KeyMap.
+func (k KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.PrevDay, k.PrevWeek, k.PrevMonth, k.PrevYear, k.Today}
+}
+
+// FullHelp implements help.KeyMap.
+func (k KeyMap) FullHelp() [][]key.Binding { return nil }
+
+// ParseWeekday parses the English name of a day of the week.
+func ParseWeekday(name string) (time.Weekday, error) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(d.String(), name) {
+			return d, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid day of the week: %q", name)
+}
@@ -0,0 +1,158 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ClockKeyMap is the key bindings of the time-of-day picker.
+type ClockKeyMap struct {
+	PrevField key.Binding
+	NextField key.Binding
+	Increment key.Binding
+	Decrement key.Binding
+}
+
+// DefaultClockKeyMap returns the default key bindings of the time-of-day
+// picker.
+func DefaultClockKeyMap() ClockKeyMap {
+	return ClockKeyMap{
+		PrevField: key.NewBinding(key.WithKeys("left", "h", "shift+tab"), key.WithHelp("←→", "field")),
+		NextField: key.NewBinding(key.WithKeys("right", "l", "tab", ":")),
+		Increment: key.NewBinding(key.WithKeys("up", "k", "+"), key.WithHelp("↑↓", "change")),
+		Decrement: key.NewBinding(key.WithKeys("down", "j", "-")),
+	}
+}
+
+// ShortHelp implements help.KeyMap.
+func (k ClockKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.PrevField, k.Increment}
+}
+
+// FullHelp implements help.KeyMap.
+func (k ClockKeyMap) FullHelp() [][]key.Binding { return nil }
+
+// Clock is a time-of-day picker editing hours, minutes and optionally
+// seconds.
+type Clock struct {
+	// Seconds shows and edits the seconds.
+	Seconds bool
+	// Min and Max bound the times of day that can be picked, whatever their
+	// date. Zero values leave the time unbounded.
+	Min, Max time.Time
+
+	KeyMap ClockKeyMap
+	// Style renders the fields, and Cursor the one being edited.
+	Style  lipgloss.Style
+	Cursor lipgloss.Style
+
+	time  time.Time
+	field int
+}
+
+// NewClock returns a time-of-day picker on the given time.
+func NewClock(t time.Time) Clock {
+	return Clock{
+		KeyMap: DefaultClockKeyMap(),
+		Cursor: DefaultStyles().Cursor,
+		time:   t.Truncate(time.Second),
+	}
+}
+
+// Time returns the picked time.
+func (c Clock) Time() time.Time { return c.time }
+
+// SetTime sets the picked time, within the bounds.
+func (c *Clock) SetTime(t time.Time) { c.time = c.clamp(t.Truncate(time.Second)) }
+
+// InRange reports whether the time of day of t is within the bounds.
+func (c Clock) InRange(t time.Time) bool {
+	return (c.Min.IsZero() || seconds(t) >= seconds(c.Min)) &&
+		(c.Max.IsZero() || seconds(t) <= seconds(c.Max))
+}
+
+// clamp moves the time of day of t within the bounds, keeping its date.
+func (c Clock) clamp(t time.Time) time.Time {
+	var bound time.Time
+	switch {
+	case !c.Min.IsZero() && seconds(t) < seconds(c.Min):
+		bound = c.Min
+	case !c.Max.IsZero() && seconds(t) > seconds(c.Max):
+		bound = c.Max
+	default:
+		return t
+	}
+	h, m, s := bound.Clock()
+	y, mo, d := t.Date()
+	return time.Date(y, mo, d, h, m, s, 0, t.Location())
+}
+
+// seconds returns the time of day of t, in seconds since midnight.
+func seconds(t time.Time) int {
+	h, m, s := t.Clock()
+	return h*3600 + m*60 + s
+}
+
+func (c Clock) fields() int {
+	if c.Seconds {
+		return 3
+	}
+	return 2
+}
+
+// step adds n units of the field being edited, wrapping around without
+// changing the day, and stopping at the bounds.
+func (c Clock) step(n int) time.Time {
+	h, m, s := c.time.Clock()
+	switch c.field {
+	case 0:
+		h = (h + n + 24) % 24
+	case 1:
+		m = (m + n + 60) % 60
+	default:
+		s = (s + n + 60) % 60
+	}
+	y, mo, d := c.time.Date()
+	return c.clamp(time.Date(y, mo, d, h, m, s, 0, c.time.Location()))
+}
+
+// Update edits the time.
+func (c Clock) Update(msg tea.Msg) (Clock, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return c, nil
+	}
+	switch {
+	case key.Matches(keyMsg, c.KeyMap.PrevField):
+		c.field = (c.field + c.fields() - 1) % c.fields()
+	case key.Matches(keyMsg, c.KeyMap.NextField):
+		c.field = (c.field + 1) % c.fields()
+	case key.Matches(keyMsg, c.KeyMap.Increment):
+		c.time = c.step(1)
+	case key.Matches(keyMsg, c.KeyMap.Decrement):
+		c.time = c.step(-1)
+	}
+	return c, nil
+}
+
+// View renders the time.
+func (c Clock) View() string {
+	h, m, s := c.time.Clock()
+	values := []int{h, m, s}[:c.fields()]
+	var out string
+	for i, v := range values {
+		if i > 0 {
+			out += c.Style.Render(":")
+		}
+		style := c.Style
+		if i == c.field {
+			style = c.Cursor
+		}
+		out += style.Render(fmt.Sprintf("%02d", v))
+	}
+	return out
+}
@@ -0,0 +1,157 @@
+// Package config reads the optional gum configuration file.
+//
+// The configuration file is a JSON document. Keys nested under a command name
+// set flag defaults for that command, while top-level keys set the flags of
+// gum itself and the few flags shared by every command (mouse, show-help and
+// timeout):
+//
+//	{
+//	  "timeout": "30s",
+//	  "spin": { "spinner": "braille" },
+//	  "spinners": { "braille": { "frames": ["⣾", "⣽", "⣻", "⢿"], "fps": 12 } }
+//	}
+//
+// Other sections (such as "spinners") are read by the components that need
+// them through [Load].
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/alecthomas/kong"
+)
+
+// Path returns the path of the configuration file.
+//
+// It is $GUM_CONFIG if set, and $XDG_CONFIG_HOME/gum/config.json otherwise.
+// The path may start with "~", see kong.ExpandPath.
+func Path() string {
+	if path := os.Getenv("GUM_CONFIG"); path != "" {
+		return path
+	}
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		dir = filepath.Join("~", ".config")
+	}
+	return filepath.Join(dir, "gum", "config.json")
+}
+
+// shared are the flags that top-level keys set for every command. Other
+// flags of the commands are only set under the name of the command, while
+// the flags of gum itself are set at the top level.
+var shared = []string{"mouse", "show-help", "timeout"}
+
+// Resolver returns a kong.Resolver resolving flag defaults from the
+// configuration file, or an error if the file is invalid.
+func Resolver() (kong.Resolver, error) {
+	sections, err := sections()
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]any, len(sections))
+	for name, raw := range sections {
+		var v any
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("invalid configuration: %w", err)
+		}
+		values[name] = v
+	}
+
+	var f kong.ResolverFunc = func(_ *kong.Context, parent *kong.Path, flag *kong.Flag) (any, error) {
+		// Environment variables take precedence over the configuration file.
+		for _, env := range flag.Envs {
+			if _, ok := os.LookupEnv(env); ok {
+				return nil, nil
+			}
+		}
+		if parent.Command == nil {
+			v, _ := lookup(values, flag.Name)
+			return v, nil
+		}
+		if section, ok := values[parent.Command.Name].(map[string]any); ok {
+			if v, ok := lookup(section, flag.Name); ok {
+				return v, nil
+			}
+		}
+		if slices.Contains(shared, flag.Name) {
+			v, _ := lookup(values, flag.Name)
+			return v, nil
+		}
+		return nil, nil
+	}
+	return f, nil
+}
+
+// lookup finds the value of the given flag, following the dots of prefixed
+// flags (e.g. "cursor.foreground") into nested objects.
+func lookup(values map[string]any, name string) (any, bool) {
+	if v, ok := values[name]; ok {
+		return scalar(v)
+	}
+	if v, ok := values[strings.ReplaceAll(name, "-", "_")]; ok {
+		return scalar(v)
+	}
+
+	var v any = values
+	for _, part := range strings.Split(name, ".") {
+		section, ok := v.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if v, ok = section[part]; !ok {
+			return nil, false
+		}
+	}
+	return scalar(v)
+}
+
+// scalar filters out objects, which are sections and not flag values.
+func scalar(v any) (any, bool) {
+	if _, ok := v.(map[string]any); ok {
+		return nil, false
+	}
+	return v, true
+}
+
+var sections = sync.OnceValues(func() (map[string]json.RawMessage, error) {
+	sections := map[string]json.RawMessage{}
+	path := kong.ExpandPath(Path())
+	bts, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return sections, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read configuration: %w", err)
+	}
+	if len(bytes.TrimSpace(bts)) == 0 {
+		return sections, nil
+	}
+	if err := json.Unmarshal(bts, &sections); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %s: %w", path, err)
+	}
+	return sections, nil
+})
+
+// Load decodes the given top-level section of the configuration file into v.
+// It does nothing if there is no configuration file or no such section.
+func Load(section string, v any) error {
+	sections, err := sections()
+	if err != nil {
+		return err
+	}
+	raw, ok := sections[section]
+	if !ok {
+		return nil
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("invalid %q configuration: %w", section, err)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+// Package editor edits text in an external editor, from a bubbletea program.
+package editor
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/editor"
+)
+
+// Options configures the editor.
+type Options struct {
+	// Name is the name of the temporary file, either a pattern for
+	// os.CreateTemp such as "gum.*.md", or the exact name of a file created
+	// in a temporary directory such as "COMMIT_EDITMSG".
+	Name string
+	// Command is the editor command, overriding $EDITOR.
+	Command string
+	// Line is the line to open the file at.
+	Line uint
+}
+
+// TempName returns the pattern of temporary files with the extension.
+func TempName(extension string) string {
+	if extension = strings.TrimPrefix(extension, "."); extension == "" {
+		return "gum.*"
+	}
+	return "gum.*." + extension
+}
+
+// FinishedMsg is sent when the editor exits, with the edited content.
+type FinishedMsg struct {
+	Content string
+	Err     error
+}
+
+// Open writes the content to a temporary file and edits it. The file is
+// removed once the editor exits.
+func Open(content string, o Options) tea.Cmd {
+	return func() tea.Msg {
+		path, cleanup, err := writeTemp(content, o.Name)
+		if err != nil {
+			return FinishedMsg{Err: err}
+		}
+		cmd, err := command(path, o)
+		if err != nil {
+			cleanup()
+			return FinishedMsg{Err: err}
+		}
+		return tea.ExecProcess(cmd, func(err error) tea.Msg {
+			defer cleanup()
+			if err != nil {
+				return FinishedMsg{Err: err}
+			}
+			bts, err := os.ReadFile(path)
+			if err != nil {
+				return FinishedMsg{Err: err}
+			}
+			return FinishedMsg{Content: string(bts)}
+		})()
+	}
+}
+
+func writeTemp(content, name string) (string, func(), error) {
+	var f *os.File
+	var err error
+	dir := ""
+	if strings.Contains(name, "*") {
+		f, err = os.CreateTemp("", name)
+	} else {
+		if dir, err = os.MkdirTemp("", "gum-*"); err != nil {
+			return "", nil, err
+		}
+		if f, err = os.Create(filepath.Join(dir, name)); err != nil {
+			_ = os.Remove(dir)
+		}
+	}
+	if err != nil {
+		return "", nil, err
+	}
+	cleanup := func() {
+		_ = os.Remove(f.Name())
+		if dir != "" {
+			_ = os.Remove(dir)
+		}
+	}
+	_, err = f.WriteString(content)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		cleanup()
+		return "", nil, err
+	}
+	return f.Name(), cleanup, nil
+}
+
+// command returns the editor command opening the path at the line.
+func command(path string, o Options) (*exec.Cmd, error) {
+	options := []editor.Option{editor.LineNumber(o.Line), editor.EndOfLine()}
+	fields := strings.Fields(o.Command)
+	if len(fields) == 0 {
+		return editor.Cmd("Gum", path, options...)
+	}
+
+	name, args := fields[0], fields[1:]
+	appendPath := true
+	for _, opt := range options {
+		optArgs, pathInArgs := opt(filepath.Base(name), path)
+		if pathInArgs {
+			appendPath = false
+		}
+		args = append(args, optArgs...)
+	}
+	if appendPath {
+		args = append(args, path)
+	}
+	return exec.Command(name, args...), nil //nolint:gosec
+}
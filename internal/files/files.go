@@ -1,37 +1,105 @@
+// Package files lists the files of a directory tree, skipping the files
+// ignored by .gitignore and .ignore files.
 package files
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"strings"
 )
 
-// List returns a list of all files in the current directory.
-// It ignores the .git directory.
-func List() []string {
-	var files []string
-	err := filepath.Walk(".",
-		func(path string, info os.FileInfo, err error) error {
-			if shouldIgnore(path) || info.IsDir() || err != nil {
-				return nil //nolint:nilerr
-			}
-			files = append(files, path)
-			return nil
-		})
+// Options configures the walk.
+type Options struct {
+	// Hidden includes files and directories starting with a dot.
+	Hidden bool
+	// FollowSymlinks walks into symlinked directories.
+	FollowSymlinks bool
+	// MaxDepth limits how deep to walk, 1 listing only the root's entries.
+	// There is no limit if it is 0.
+	MaxDepth int
+	// Type lists only files ("f") or directories ("d"). Both are listed if
+	// it is empty.
+	Type string
+}
 
-	if err != nil {
-		return []string{}
+// Walk calls fn with the path of every file under root, directory by
+// directory in lexical order. The .git directory and ignored files are
+// skipped, and so are unreadable directories. It stops early if the context
+// is done.
+func Walk(ctx context.Context, root string, o Options, fn func(path string)) error {
+	w := walker{ctx: ctx, o: o, fn: fn, visited: map[string]bool{}}
+	if real, err := realPath(root); err == nil {
+		w.visited[real] = true
 	}
+	return w.walk(root, 1, append(defaultRules, readRules(root)...))
+}
+
+// List returns the paths of all files under the current directory.
+func List(o Options) []string {
+	var files []string
+	_ = Walk(context.Background(), ".", o, func(path string) {
+		files = append(files, path)
+	})
 	return files
 }
 
-var defaultIgnorePatterns = []string{"node_modules", ".git", "."}
+type walker struct {
+	ctx context.Context
+	o   Options
+	fn  func(path string)
+	// visited are the real paths of the walked directories, to avoid
+	// symlink loops.
+	visited map[string]bool
+}
 
-func shouldIgnore(path string) bool {
-	for _, prefix := range defaultIgnorePatterns {
-		if strings.HasPrefix(path, prefix) {
-			return true
+func (w walker) walk(dir string, depth int, rules []rule) error {
+	if err := w.ctx.Err(); err != nil {
+		return err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil //nolint:nilerr
+	}
+	for _, e := range entries {
+		name := e.Name()
+		if name == ".git" || (!w.o.Hidden && strings.HasPrefix(name, ".")) {
+			continue
 		}
+		path := filepath.Join(dir, name)
+		isDir := e.IsDir()
+		if e.Type()&os.ModeSymlink != 0 && w.o.FollowSymlinks {
+			if info, err := os.Stat(path); err == nil {
+				isDir = info.IsDir()
+			}
+		}
+		if ignored(rules, path, isDir) {
+			continue
+		}
+
+		if w.o.Type == "" || (w.o.Type == "d") == isDir {
+			w.fn(path)
+		}
+		if !isDir || (w.o.MaxDepth > 0 && depth >= w.o.MaxDepth) {
+			continue
+		}
+		real, err := realPath(path)
+		if err != nil || w.visited[real] {
+			continue
+		}
+		w.visited[real] = true
+		if err := w.walk(path, depth+1, append(rules[:len(rules):len(rules)], readRules(path)...)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// realPath returns the absolute path of the file, with symlinks resolved.
+func realPath(path string) (string, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
 	}
-	return false
+	return filepath.EvalSymlinks(abs)
 }
@@ -0,0 +1,146 @@
+package files
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// ignoreFiles are read in every walked directory, later ones taking
+// precedence.
+var ignoreFiles = []string{".gitignore", ".ignore"}
+
+// rule is a pattern of an ignore file.
+type rule struct {
+	// base is the directory of the ignore file, relative to the walked root.
+	base    string
+	re      *regexp.Regexp
+	negate  bool
+	dirOnly bool
+}
+
+// defaultRules skips dependency directories at any depth, unless an ignore
+// file re-includes them.
+var defaultRules = parseRules(".", "node_modules/")
+
+// readRules reads the ignore files of the directory.
+func readRules(dir string) []rule {
+	var rules []rule
+	for _, name := range ignoreFiles {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			continue
+		}
+		rules = append(rules, parseRules(dir, string(data))...)
+	}
+	return rules
+}
+
+// parseRules parses gitignore patterns.
+func parseRules(base, data string) []rule {
+	var rules []rule
+	s := bufio.NewScanner(strings.NewReader(data))
+	for s.Scan() {
+		line := strings.TrimSuffix(s.Text(), "\r")
+		if !strings.HasSuffix(line, `\ `) {
+			line = strings.TrimRight(line, " ")
+		}
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		r := rule{base: filepath.ToSlash(base)}
+		if strings.HasPrefix(line, "!") {
+			r.negate = true
+			line = line[1:]
+		} else if strings.HasPrefix(line, `\!`) || strings.HasPrefix(line, `\#`) {
+			line = line[1:]
+		}
+		if strings.HasSuffix(line, "/") {
+			r.dirOnly = true
+			line = strings.TrimRight(line, "/")
+		}
+		if line == "" {
+			continue
+		}
+
+		// Patterns with a slash are relative to the ignore file, others
+		// match names at any depth.
+		anchored := strings.Contains(line, "/")
+		line = strings.TrimPrefix(line, "/")
+		expr := "^" + glob(line) + "$"
+		if !anchored {
+			expr = "^(?:.*/)?" + glob(line) + "$"
+		}
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			continue
+		}
+		r.re = re
+		rules = append(rules, r)
+	}
+	return rules
+}
+
+// glob translates a gitignore pattern to a regular expression.
+func glob(pattern string) string {
+	var b strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		atStart := i == 0 || pattern[i-1] == '/'
+		switch c := pattern[i]; {
+		case atStart && strings.HasPrefix(pattern[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case atStart && pattern[i:] == "**":
+			b.WriteString(".*")
+			i++
+		case c == '*':
+			b.WriteString("[^/]*")
+		case c == '?':
+			b.WriteString("[^/]")
+		case c == '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+			if end < 0 {
+				b.WriteString(`\[`)
+				continue
+			}
+			class := pattern[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			b.WriteString("[" + strings.ReplaceAll(class, `\`, `\\`) + "]")
+			i += end + 1
+		case c == '\\' && i+1 < len(pattern):
+			i++
+			b.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	return b.String()
+}
+
+// ignored reports whether the path, relative to the walked root, is ignored
+// by the rules. The last matching rule wins.
+func ignored(rules []rule, path string, isDir bool) bool {
+	path = filepath.ToSlash(path)
+	ignore := false
+	for _, r := range rules {
+		if r.dirOnly && !isDir {
+			continue
+		}
+		rel := path
+		if r.base != "." {
+			var ok bool
+			if rel, ok = strings.CutPrefix(path, r.base+"/"); !ok {
+				continue
+			}
+		}
+		if r.re.MatchString(rel) {
+			ignore = !r.negate
+		}
+	}
+	return ignore
+}
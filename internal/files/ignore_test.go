@@ -0,0 +1,78 @@
+package files
+
+import (
+	"testing"
+)
+
+func TestIgnored(t *testing.T) {
+	for name, tt := range map[string]struct {
+		base  string
+		rules string
+		path  string
+		isDir bool
+		out   bool
+	}{
+		"name at any depth":         {rules: "*.log", path: "a/b/debug.log", out: true},
+		"name not matching":         {rules: "*.log", path: "a/debug.txt", out: false},
+		"question mark":             {rules: "file?.txt", path: "file1.txt", out: true},
+		"question mark not slash":   {rules: "a?b", path: "a/b", out: false},
+		"anchored to the root":      {rules: "/root.txt", path: "root.txt", out: true},
+		"anchored not below":        {rules: "/root.txt", path: "sub/root.txt", out: false},
+		"slash anchors":             {rules: "doc/*.txt", path: "doc/a.txt", out: true},
+		"star stops at slashes":     {rules: "doc/*.txt", path: "doc/sub/a.txt", out: false},
+		"anchored not nested":       {rules: "doc/*.txt", path: "sub/doc/a.txt", out: false},
+		"leading double star":       {rules: "**/build", path: "a/b/build", out: true},
+		"leading double star root":  {rules: "**/build", path: "build", out: true},
+		"trailing double star":      {rules: "logs/**", path: "logs/a/b.txt", out: true},
+		"trailing double star only": {rules: "logs/**", path: "logs", isDir: true, out: false},
+		"middle double star":        {rules: "a/**/b", path: "a/x/y/b", out: true},
+		"middle double star none":   {rules: "a/**/b", path: "a/b", out: true},
+		"middle double star prefix": {rules: "a/**/b", path: "xa/b", out: false},
+		"middle double star suffix": {rules: "a/**/b", path: "a/x/bc", out: false},
+		"dir only matches dirs":     {rules: "build/", path: "build", isDir: true, out: true},
+		"dir only skips files":      {rules: "build/", path: "build", out: false},
+		"negation":                  {rules: "*.log\n!keep.log", path: "keep.log", out: false},
+		"negation last wins":        {rules: "!keep.log\n*.log", path: "keep.log", out: true},
+		"re-include a directory":    {rules: "logs/*\n!logs/keep/", path: "logs/keep", isDir: true, out: false},
+		"re-include only the dir":   {rules: "logs/*\n!logs/keep/", path: "logs/other", isDir: true, out: true},
+		"re-include a default":      {rules: "node_modules/\n!node_modules/", path: "node_modules", isDir: true, out: false},
+		"character class":           {rules: "*.[oa]", path: "lib.a", out: true},
+		"character class miss":      {rules: "*.[oa]", path: "lib.c", out: false},
+		"negated character class":   {rules: "[!a]bc", path: "xbc", out: true},
+		"negated class miss":        {rules: "[!a]bc", path: "abc", out: false},
+		"character range":           {rules: "v[0-9]", path: "v7", out: true},
+		"unclosed bracket":          {rules: "a[b", path: "a[b", out: true},
+		"escaped star":              {rules: `foo\*`, path: "foo*", out: true},
+		"escaped star literal":      {rules: `foo\*`, path: "foobar", out: false},
+		"escaped hash":              {rules: `\#notes`, path: "#notes", out: true},
+		"comment":                   {rules: "#notes", path: "#notes", out: false},
+		"escaped bang":              {rules: `\!important`, path: "!important", out: true},
+		"escaped trailing space":    {rules: `trailing\ `, path: "trailing ", out: true},
+		"trailing spaces trimmed":   {rules: "name   ", path: "name", out: true},
+		"dots are literal":          {rules: "a.b", path: "axb", out: false},
+		"nested ignore file":        {base: "sub", rules: "*.log", path: "sub/x/a.log", out: true},
+		"nested outside its dir":    {base: "sub", rules: "*.log", path: "a.log", out: false},
+		"nested anchored":           {base: "sub", rules: "/a.log", path: "sub/a.log", out: true},
+		"nested anchored not below": {base: "sub", rules: "/a.log", path: "sub/x/a.log", out: false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			base := tt.base
+			if base == "" {
+				base = "."
+			}
+			rules := append(parseRules(".", "node_modules/"), parseRules(base, tt.rules)...)
+			if got := ignored(rules, tt.path, tt.isDir); got != tt.out {
+				t.Errorf("expected %q ignored to be %v with rules %q, got %v", tt.path, tt.out, tt.rules, got)
+			}
+		})
+	}
+}
+
+func TestDefaultRules(t *testing.T) {
+	if !ignored(defaultRules, "a/node_modules", true) {
+		t.Error("expected node_modules to be ignored at any depth")
+	}
+	if ignored(defaultRules, "node_modules", false) {
+		t.Error("expected a node_modules file not to be ignored")
+	}
+}
@@ -0,0 +1,195 @@
+// Package history keeps track of the choices made in pickers, so frequently
+// and recently picked items can be ranked first.
+//
+// The store is a JSON file under $XDG_STATE_HOME/gum/ holding, for each
+// history key, how many times each item was picked and when it was last
+// picked.
+package history
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+)
+
+// Entry is the history of a picked item.
+type Entry struct {
+	Item  string    `json:"item"`
+	Count int       `json:"count"`
+	Last  time.Time `json:"last"`
+}
+
+// Frecency scores the entry from how many times and how recently it was
+// picked.
+func (e Entry) Frecency(now time.Time) float64 {
+	age := now.Sub(e.Last)
+	weight := 0.25
+	switch {
+	case age < 4*time.Hour:
+		weight = 4
+	case age < 24*time.Hour:
+		weight = 2
+	case age < 7*24*time.Hour:
+		weight = 1
+	case age < 30*24*time.Hour:
+		weight = 0.5
+	}
+	return float64(e.Count) * weight
+}
+
+// Path returns the path of the history store.
+//
+// It is $XDG_STATE_HOME/gum/history.json, or ~/.local/state/gum/history.json
+// if $XDG_STATE_HOME is not set.
+func Path() (string, error) {
+	dir := os.Getenv("XDG_STATE_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("unable to find history: %w", err)
+		}
+		dir = filepath.Join(home, ".local", "state")
+	}
+	return filepath.Join(dir, "gum", "history.json"), nil
+}
+
+type store map[string]map[string]Entry
+
+func load() (store, string, error) {
+	path, err := Path()
+	if err != nil {
+		return nil, "", err
+	}
+	s := store{}
+	bts, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, path, nil
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to read history: %w", err)
+	}
+	if err := json.Unmarshal(bts, &s); err != nil {
+		return nil, "", fmt.Errorf("invalid history %s: %w", path, err)
+	}
+	return s, path, nil
+}
+
+func (s store) save(path string) error {
+	bts, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("unable to write history: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("unable to write history: %w", err)
+	}
+	// Write to a temporary file first so concurrent pickers never read a
+	// partially written store.
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".history-*.json")
+	if err != nil {
+		return fmt.Errorf("unable to write history: %w", err)
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck
+	if _, err := tmp.Write(bts); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("unable to write history: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("unable to write history: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("unable to write history: %w", err)
+	}
+	return nil
+}
+
+// Record marks the given items as picked now under the given key.
+func Record(key string, items ...string) error {
+	if key == "" || len(items) == 0 {
+		return nil
+	}
+	s, path, err := load()
+	if err != nil {
+		return err
+	}
+	entries := s[key]
+	if entries == nil {
+		entries = map[string]Entry{}
+		s[key] = entries
+	}
+	now := time.Now()
+	for _, item := range items {
+		e := entries[item]
+		e.Item = item
+		e.Count++
+		e.Last = now
+		entries[item] = e
+	}
+	return s.save(path)
+}
+
+// Scores returns the frecency of the items picked under the given key.
+func Scores(key string) (map[string]float64, error) {
+	if key == "" {
+		return nil, nil
+	}
+	s, _, err := load()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	scores := make(map[string]float64, len(s[key]))
+	for item, e := range s[key] {
+		scores[item] = e.Frecency(now)
+	}
+	return scores, nil
+}
+
+// Keys returns the sorted history keys.
+func Keys() ([]string, error) {
+	s, _, err := load()
+	if err != nil {
+		return nil, err
+	}
+	return slices.Sorted(maps.Keys(s)), nil
+}
+
+// Entries returns the entries of the given key, most frecent first.
+func Entries(key string) ([]Entry, error) {
+	s, _, err := load()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	entries := slices.Collect(maps.Values(s[key]))
+	slices.SortFunc(entries, func(a, b Entry) int {
+		if fa, fb := a.Frecency(now), b.Frecency(now); fa != fb {
+			if fa > fb {
+				return -1
+			}
+			return 1
+		}
+		return b.Last.Compare(a.Last)
+	})
+	return entries, nil
+}
+
+// Clear removes the history of the given keys, or all of it if no key is
+// given.
+func Clear(keys ...string) error {
+	s, path, err := load()
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		clear(s)
+	}
+	for _, key := range keys {
+		delete(s, key)
+	}
+	return s.save(path)
+}
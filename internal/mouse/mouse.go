@@ -0,0 +1,121 @@
+// Package mouse locates the views of the components on the terminal, so
+// that clicks can be mapped to their lines.
+//
+// Views rendered inline start on the line of the cursor when the program
+// starts, which is asked to the terminal, and move up as the terminal scrolls
+// when they grow past its bottom.
+package mouse
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/term"
+	"github.com/muesli/cancelreader"
+)
+
+// reportTimeout is how long the terminal has to report the cursor position.
+const reportTimeout = 500 * time.Millisecond
+
+// Tracker follows where a view rendered inline is on the terminal. A nil
+// Tracker follows nothing, and maps no click.
+type Tracker struct {
+	// top is the row of the first line of the view, and height the height
+	// of the terminal.
+	top     int
+	height  int
+	located bool
+}
+
+// New returns a tracker of a view starting on the line of the cursor. If the
+// terminal does not report the cursor position, clicks are not mapped.
+func New() *Tracker {
+	t := &Tracker{}
+	tty, err := os.OpenFile("/dev/tty", os.O_RDWR, 0)
+	if err != nil {
+		return t
+	}
+	defer tty.Close() //nolint:errcheck
+	if _, t.height, err = term.GetSize(tty.Fd()); err != nil {
+		return t
+	}
+	if t.top, err = cursorRow(tty); err != nil {
+		return t
+	}
+	t.located = true
+	return t
+}
+
+// cursorRow asks the terminal for the row of the cursor, from 0.
+func cursorRow(tty *os.File) (int, error) {
+	state, err := term.MakeRaw(tty.Fd())
+	if err != nil {
+		return 0, err
+	}
+	defer term.Restore(tty.Fd(), state) //nolint:errcheck
+
+	r, err := cancelreader.NewReader(tty)
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close() //nolint:errcheck
+	if _, err := tty.WriteString(ansi.RequestCursorPositionReport); err != nil {
+		return 0, err
+	}
+	timer := time.AfterFunc(reportTimeout, func() { r.Cancel() })
+	defer timer.Stop()
+
+	var report []byte
+	b := make([]byte, 1)
+	for !bytes.HasSuffix(report, []byte("R")) {
+		if _, err := r.Read(b); err != nil {
+			return 0, fmt.Errorf("no cursor position: %w", err)
+		}
+		report = append(report, b[0])
+	}
+	i := bytes.LastIndexByte(report, ansi.ESC)
+	if i < 0 {
+		return 0, errors.New("invalid cursor position")
+	}
+	var row, col int
+	if _, err := fmt.Sscanf(string(report[i:]), "\x1b[%d;%dR", &row, &col); err != nil {
+		return 0, fmt.Errorf("invalid cursor position: %w", err)
+	}
+	return row - 1, nil
+}
+
+// Update follows the size of the terminal.
+func (t *Tracker) Update(msg tea.Msg) {
+	if msg, ok := msg.(tea.WindowSizeMsg); ok && t != nil {
+		t.height = msg.Height
+	}
+}
+
+// Render follows the height of the view, and returns it. The view moves up
+// if it does not fit below its top.
+func (t *Tracker) Render(view string) string {
+	if t != nil && t.height > 0 {
+		t.top = min(t.top, t.height-lipgloss.Height(view))
+	}
+	return view
+}
+
+// Line returns the line of the view under the mouse, or -1 if the view is
+// not located.
+func (t *Tracker) Line(msg tea.MouseMsg) int {
+	if t == nil || !t.located {
+		return -1
+	}
+	return msg.Y - t.top
+}
+
+// Fullscreen returns a tracker of a view drawn on the alternate screen.
+func Fullscreen() *Tracker {
+	return &Tracker{located: true}
+}
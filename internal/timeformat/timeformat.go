@@ -0,0 +1,52 @@
+// Package timeformat resolves the named time layouts accepted by gum.
+package timeformat
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Formats maps the layout names to Go time layouts.
+var Formats = map[string]string{
+	"layout":      time.Layout,
+	"ansic":       time.ANSIC,
+	"unixdate":    time.UnixDate,
+	"rubydate":    time.RubyDate,
+	"rfc822":      time.RFC822,
+	"rfc822z":     time.RFC822Z,
+	"rfc850":      time.RFC850,
+	"rfc1123":     time.RFC1123,
+	"rfc1123z":    time.RFC1123Z,
+	"rfc3339":     time.RFC3339,
+	"rfc3339nano": time.RFC3339Nano,
+	"kitchen":     time.Kitchen,
+	"stamp":       time.Stamp,
+	"stampmilli":  time.StampMilli,
+	"stampmicro":  time.StampMicro,
+	"stampnano":   time.StampNano,
+	"datetime":    time.DateTime,
+	"dateonly":    time.DateOnly,
+	"timeonly":    time.TimeOnly,
+}
+
+// Layout returns the layout of the given name, or the name itself if it is
+// not a known name, so that custom layouts can be used too.
+func Layout(name string) string {
+	if layout, ok := Formats[strings.ToLower(name)]; ok {
+		return layout
+	}
+	return name
+}
+
+// Parse parses the value in the local time zone with the first of the layouts
+// that matches it.
+func Parse(value string, layouts ...string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range layouts {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%q does not match %s", value, layouts[0])
+}
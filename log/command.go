@@ -5,8 +5,8 @@ import (
 	"math"
 	"os"
 	"strings"
-	"time"
 
+	"github.com/charmbracelet/gum/internal/timeformat"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/log"
 )
@@ -36,34 +36,7 @@ func (o Options) Run() error {
 		l.SetLevel(lvl)
 	}
 
-	timeFormats := map[string]string{
-		"layout":      time.Layout,
-		"ansic":       time.ANSIC,
-		"unixdate":    time.UnixDate,
-		"rubydate":    time.RubyDate,
-		"rfc822":      time.RFC822,
-		"rfc822z":     time.RFC822Z,
-		"rfc850":      time.RFC850,
-		"rfc1123":     time.RFC1123,
-		"rfc1123z":    time.RFC1123Z,
-		"rfc3339":     time.RFC3339,
-		"rfc3339nano": time.RFC3339Nano,
-		"kitchen":     time.Kitchen,
-		"stamp":       time.Stamp,
-		"stampmilli":  time.StampMilli,
-		"stampmicro":  time.StampMicro,
-		"stampnano":   time.StampNano,
-		"datetime":    time.DateTime,
-		"dateonly":    time.DateOnly,
-		"timeonly":    time.TimeOnly,
-	}
-
-	tf, ok := timeFormats[strings.ToLower(o.Time)]
-	if ok {
-		l.SetTimeFormat(tf)
-	} else {
-		l.SetTimeFormat(o.Time)
-	}
+	l.SetTimeFormat(timeformat.Layout(o.Time))
 
 	st := log.DefaultStyles()
 	lvl := levelToLog[o.Level]
@@ -8,6 +8,9 @@ import (
 
 	"github.com/alecthomas/kong"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/gum/internal/accessible"
+	"github.com/charmbracelet/gum/internal/answer"
+	"github.com/charmbracelet/gum/internal/config"
 	"github.com/charmbracelet/gum/internal/exit"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/muesli/termenv"
@@ -42,11 +45,18 @@ func main() {
 		version += " (" + CommitSHA[:shaLen] + ")"
 	}
 
+	resolver, err := config.Resolver()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
 	gum := &Gum{}
 	ctx := kong.Parse(
 		gum,
 		kong.Description(fmt.Sprintf("A tool for %s shell scripts.", bubbleGumPink.Render("glamorous"))),
 		kong.UsageOnError(),
+		kong.Resolvers(resolver),
 		kong.ConfigureHelp(kong.HelpOptions{
 			Compact:             true,
 			Summary:             false,
@@ -72,6 +82,9 @@ func main() {
 			"defaultStrikethrough":    "false",
 		},
 	)
+	answer.AssumeYes = gum.Yes
+	answer.File = gum.Answers
+	accessible.Enabled = gum.Accessible
 	if err := ctx.Run(); err != nil {
 		var ex exit.ErrExit
 		if errors.As(err, &ex) {
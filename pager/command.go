@@ -3,12 +3,16 @@ package pager
 import (
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/gum/internal/accessible"
+	"github.com/charmbracelet/gum/internal/bindings"
 	"github.com/charmbracelet/gum/internal/stdin"
 	"github.com/charmbracelet/gum/internal/timeout"
+	"github.com/charmbracelet/x/ansi"
 )
 
 // Run provides a shell script interface for the viewport bubble.
@@ -31,6 +35,11 @@ func (o Options) Run() error {
 		}
 	}
 
+	if accessible.On() {
+		printContent(o.Content, o.ShowLineNumbers)
+		return nil
+	}
+
 	m := model{
 		viewport:            vp,
 		help:                help.New(),
@@ -43,19 +52,39 @@ func (o Options) Run() error {
 		matchHighlightStyle: o.MatchHighlightStyle.ToLipgloss(),
 		keymap:              defaultKeymap(),
 	}
+	if err := bindings.Remap("pager", o.Keymap, &m.keymap); err != nil {
+		return err
+	}
+	m.viewport.KeyMap = m.keymap.KeyMap
 
 	ctx, cancel := timeout.Context(o.Timeout)
 	defer cancel()
 
-	_, err := tea.NewProgram(
-		m,
+	options := []tea.ProgramOption{
 		tea.WithAltScreen(),
 		tea.WithReportFocus(),
 		tea.WithContext(ctx),
-	).Run()
+	}
+	if o.Mouse {
+		options = append(options, tea.WithMouseCellMotion())
+	}
+	_, err := tea.NewProgram(m, options...).Run()
 	if err != nil {
 		return fmt.Errorf("unable to start program: %w", err)
 	}
 
 	return nil
 }
+
+// printContent prints the content as is, without styles, instead of paging
+// it.
+func printContent(content string, lineNumbers bool) {
+	content = ansi.Strip(content)
+	if !lineNumbers {
+		fmt.Println(content)
+		return
+	}
+	for i, line := range strings.Split(content, "\n") {
+		fmt.Printf("%d: %s\n", i+1, line)
+	}
+}
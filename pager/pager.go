@@ -12,11 +12,13 @@ import (
 	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/gum/internal/bindings"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/x/ansi"
 )
 
 type keymap struct {
+	viewport.KeyMap
 	Home,
 	End,
 	Search,
@@ -36,10 +38,7 @@ func (k keymap) FullHelp() [][]key.Binding {
 // ShortHelp implements help.KeyMap.
 func (k keymap) ShortHelp() []key.Binding {
 	return []key.Binding{
-		key.NewBinding(
-			key.WithKeys("up", "down"),
-			key.WithHelp("↓↑", "navigate"),
-		),
+		bindings.Help("navigate", k.Down, k.Up),
 		k.Quit,
 		k.Search,
 		k.NextMatch,
@@ -49,6 +48,7 @@ func (k keymap) ShortHelp() []key.Binding {
 
 func defaultKeymap() keymap {
 	return keymap{
+		KeyMap: viewport.DefaultKeyMap(),
 		Home: key.NewBinding(
 			key.WithKeys("g", "home"),
 			key.WithHelp("h", "home"),
@@ -111,6 +111,11 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.processText(msg)
 	case tea.KeyMsg:
 		return m.keyHandler(msg)
+	case tea.MouseMsg:
+		// The wheel scrolls, if the mouse is enabled.
+		var cmd tea.Cmd
+		m.viewport, cmd = m.viewport.Update(msg)
+		return m, cmd
 	}
 
 	m.keymap.PrevMatch.SetEnabled(m.search.query != nil)
@@ -18,6 +18,25 @@ func ClearView(clearView bool) func(*Options) {
 	return func(o *Options) { o.ClearView = clearView }
 }
 
+func Title(title string) func(*Options) {
+	return func(o *Options) { o.Title = title }
+}
+
+func TitleUpdates(titleUpdates bool) func(*Options) {
+	return func(o *Options) { o.TitleUpdates = titleUpdates }
+}
+
+func Spinner(spinner string) func(*Options) {
+	return func(o *Options) { o.Spinner = spinner }
+}
+
+func SpinnerFrames(frames []string, fps int) func(*Options) {
+	return func(o *Options) {
+		o.SpinnerFrames = frames
+		o.SpinnerFPS = fps
+	}
+}
+
 func Spin(optionsFn ...func(*Options)) error {
 	option := &Options{}
 	bingoo.KongParse(option, bingoo.KongVars)
@@ -1,13 +1,17 @@
 package spin
 
 import (
+	"bufio"
 	"fmt"
 	"os"
+	"runtime"
 
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/gum/internal/accessible"
 	"github.com/charmbracelet/gum/internal/exit"
 	"github.com/charmbracelet/gum/internal/timeout"
+	"github.com/charmbracelet/gum/spinners"
 	"github.com/charmbracelet/x/term"
 )
 
@@ -17,13 +21,19 @@ func (o Options) RunBingoo() error {
 	isOutTTY := term.IsTerminal(os.Stdout.Fd())
 	isErrTTY := term.IsTerminal(os.Stderr.Fd())
 
+	sp, err := spinners.Resolve(o.Spinner, o.SpinnerFrames, o.SpinnerFPS)
+	if err != nil {
+		return err
+	}
+
 	s := spinner.New()
 	s.Style = o.SpinnerStyle.ToLipgloss()
-	s.Spinner = spinnerMap[o.Spinner]
+	s.Spinner = sp
 	m := model{
 		spinner:    s,
 		title:      o.TitleStyle.ToLipgloss().Render(o.Title),
 		titleFn:    o.TitleFn,
+		titleStyle: o.TitleStyle.ToLipgloss(),
 		anyKey:     o.AnyKey,
 		command:    o.Command,
 		align:      o.Align,
@@ -32,6 +42,19 @@ func (o Options) RunBingoo() error {
 		showError:  o.ShowError,
 		isTTY:      isErrTTY,
 		clearView:  o.ClearView,
+		accessible: accessible.On(),
+	}
+
+	// Passing extra file descriptors to the command is not supported on
+	// Windows.
+	if o.TitleUpdates && len(o.Command) > 0 && runtime.GOOS != "windows" {
+		r, w, err := os.Pipe()
+		if err != nil {
+			return fmt.Errorf("unable to create title pipe: %w", err)
+		}
+		defer r.Close() //nolint:errcheck
+		m.titleIn = bufio.NewScanner(r)
+		m.titleOut = w
 	}
 
 	ctx, cancel := timeout.Context(o.Timeout)
@@ -44,6 +67,10 @@ func (o Options) RunBingoo() error {
 	if len(o.Command) > 0 {
 		opts = append(opts, tea.WithInput(nil))
 	}
+	if m.accessible {
+		accessible.Println(m.getTitle())
+		opts = append(opts, tea.WithoutRenderer())
+	}
 	tm, err := tea.NewProgram(m, opts...).Run()
 	if err != nil {
 		return fmt.Errorf("unable to run action: %w", err)
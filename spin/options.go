@@ -10,17 +10,20 @@ import (
 type Options struct {
 	Command []string `arg:"" optional:"" help:"Command to run"`
 
-	ShowOutput   bool          `help:"Show or pipe output of command during execution (shows both STDOUT and STDERR)" default:"false" env:"GUM_SPIN_SHOW_OUTPUT"`
-	ShowError    bool          `help:"Show output of command only if the command fails" default:"false" env:"GUM_SPIN_SHOW_ERROR"`
-	ShowStdout   bool          `help:"Show STDOUT output" default:"false" env:"GUM_SPIN_SHOW_STDOUT"`
-	ShowStderr   bool          `help:"Show STDERR errput" default:"false" env:"GUM_SPIN_SHOW_STDERR"`
-	Spinner      string        `help:"Spinner type" short:"s" type:"spinner" enum:"line,dot,minidot,jump,pulse,points,globe,moon,monkey,meter,hamburger" default:"dot" env:"GUM_SPIN_SPINNER"`
-	SpinnerStyle style.Styles  `embed:"" prefix:"spinner." set:"defaultForeground=212" envprefix:"GUM_SPIN_SPINNER_"`
-	Title        string        `help:"Text to display to user while spinning" default:"Loading..." env:"GUM_SPIN_TITLE"`
-	TitleFn      func() string `kong:"-"`
-	AnyKey       bool          `help:"Allow any key to interrupt the spinner" default:"false" env:"GUM_SPIN_ANY_KEY"`
-	ClearView    bool          `help:"Clear the view before spinning" default:"true" env:"GUM_SPIN_CLEAR_VIEW"`
-	TitleStyle   style.Styles  `embed:"" prefix:"title." envprefix:"GUM_SPIN_TITLE_"`
-	Align        string        `help:"Alignment of spinner with regard to the title" short:"a" type:"align" enum:"left,right" default:"left" env:"GUM_SPIN_ALIGN"`
-	Timeout      time.Duration `help:"Timeout until spin command aborts" default:"0s" env:"GUM_SPIN_TIMEOUT"`
+	ShowOutput    bool          `help:"Show or pipe output of command during execution (shows both STDOUT and STDERR)" default:"false" env:"GUM_SPIN_SHOW_OUTPUT"`
+	ShowError     bool          `help:"Show output of command only if the command fails" default:"false" env:"GUM_SPIN_SHOW_ERROR"`
+	ShowStdout    bool          `help:"Show STDOUT output" default:"false" env:"GUM_SPIN_SHOW_STDOUT"`
+	ShowStderr    bool          `help:"Show STDERR errput" default:"false" env:"GUM_SPIN_SHOW_STDERR"`
+	Spinner       string        `help:"Spinner type (line,dot,minidot,jump,pulse,points,globe,moon,monkey,meter,hamburger or one defined in the config file)" short:"s" type:"spinner" default:"dot" env:"GUM_SPIN_SPINNER"`
+	SpinnerFrames []string      `help:"Custom spinner frames, overrides the spinner type" sep:"," env:"GUM_SPIN_SPINNER_FRAMES"`
+	SpinnerFPS    int           `help:"Spinner frames per second (0 for the spinner's default)" default:"0" env:"GUM_SPIN_SPINNER_FPS"`
+	SpinnerStyle  style.Styles  `embed:"" prefix:"spinner." set:"defaultForeground=212" envprefix:"GUM_SPIN_SPINNER_"`
+	Title         string        `help:"Text to display to user while spinning" default:"Loading..." env:"GUM_SPIN_TITLE"`
+	TitleFn       func() string `kong:"-"`
+	TitleUpdates  bool          `help:"Let the command update the title by writing lines to the file descriptor in $GUM_SPIN_FD" default:"false" env:"GUM_SPIN_TITLE_UPDATES"`
+	AnyKey        bool          `help:"Allow any key to interrupt the spinner" default:"false" env:"GUM_SPIN_ANY_KEY"`
+	ClearView     bool          `help:"Clear the view before spinning" default:"true" env:"GUM_SPIN_CLEAR_VIEW"`
+	TitleStyle    style.Styles  `embed:"" prefix:"title." envprefix:"GUM_SPIN_TITLE_"`
+	Align         string        `help:"Alignment of spinner with regard to the title" short:"a" type:"align" enum:"left,right" default:"left" env:"GUM_SPIN_ALIGN"`
+	Timeout       time.Duration `help:"Timeout until spin command aborts" default:"0s" env:"GUM_SPIN_TIMEOUT"`
 }
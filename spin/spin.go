@@ -15,16 +15,21 @@
 package spin
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"io"
 	"os"
 	"os/exec"
 	"runtime"
+	"strconv"
+	"strings"
 	"syscall"
 
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/gum/internal/accessible"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/x/term"
 	"github.com/charmbracelet/x/xpty"
 )
@@ -33,12 +38,18 @@ type model struct {
 	spinner    spinner.Model
 	title      string
 	titleFn    func() string
+	titleStyle lipgloss.Style
+	titleIn    *bufio.Scanner
+	titleOut   *os.File
 	anyKey     bool
 	align      string
 	command    []string
 	quitting   bool
 	clearView  bool
 	isTTY      bool
+	// accessible prints the title on a line, and each update on the next
+	// one, rather than animating the spinner.
+	accessible bool
 	status     int
 	stdout     string
 	stderr     string
@@ -66,6 +77,28 @@ var (
 
 type errorMsg error
 
+// titleFd is the file descriptor the command can write title updates to.
+// It is advertised to the command through the GUM_SPIN_FD variable.
+const titleFd = 3
+
+type titleMsg string
+
+// readTitle waits for the next line the command writes to the title pipe.
+func readTitle(in *bufio.Scanner) tea.Cmd {
+	return func() tea.Msg {
+		if !in.Scan() {
+			return nil
+		}
+		// Only keep what comes after the last carriage return, so progress
+		// style output (e.g. "\r3/10") shows the latest value.
+		line := in.Text()
+		if i := strings.LastIndex(line, "\r"); i >= 0 {
+			line = line[i+1:]
+		}
+		return titleMsg(line)
+	}
+}
+
 type finishCommandMsg struct {
 	stdout string
 	stderr string
@@ -73,7 +106,7 @@ type finishCommandMsg struct {
 	status int
 }
 
-func commandStart(command []string) tea.Cmd {
+func commandStart(command []string, titleOut *os.File) tea.Cmd {
 	return func() tea.Msg {
 		var args []string
 		if len(command) > 1 {
@@ -82,6 +115,14 @@ func commandStart(command []string) tea.Cmd {
 
 		executing = exec.Command(command[0], args...) //nolint:gosec
 		executing.Stdin = os.Stdin
+		if titleOut != nil {
+			// ExtraFiles[0] becomes file descriptor 3 in the command.
+			executing.ExtraFiles = []*os.File{titleOut}
+			executing.Env = append(os.Environ(), "GUM_SPIN_FD="+strconv.Itoa(titleFd))
+			// Close our copy of the pipe once the command is done, so that
+			// reading the title updates stops.
+			defer titleOut.Close() //nolint:errcheck
+		}
 
 		isTerminal := term.IsTerminal(os.Stdout.Fd())
 
@@ -148,9 +189,15 @@ func commandAbort() tea.Msg {
 }
 
 func (m model) Init() tea.Cmd {
-	cmds := []tea.Cmd{m.spinner.Tick}
+	var cmds []tea.Cmd
+	if !m.accessible {
+		cmds = append(cmds, m.spinner.Tick)
+	}
 	if len(m.command) > 0 {
-		cmds = append(cmds, commandStart(m.command))
+		cmds = append(cmds, commandStart(m.command, m.titleOut))
+	}
+	if m.titleIn != nil {
+		cmds = append(cmds, readTitle(m.titleIn))
 	}
 
 	return tea.Batch(cmds...)
@@ -200,6 +247,12 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.quitting = true
 			return m, tea.Quit
 		}
+	case titleMsg:
+		m.title = m.titleStyle.Render(string(msg))
+		if m.accessible {
+			accessible.Println(m.title)
+		}
+		return m, readTitle(m.titleIn)
 	case errorMsg:
 		m.err = msg
 		m.quitting = true
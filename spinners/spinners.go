@@ -0,0 +1,88 @@
+// Package spinners provides the spinners shared by the components that show
+// progress, such as spin and tickwait.
+//
+// Besides the built-in spinners, named spinners can be defined in the
+// "spinners" section of the configuration file:
+//
+//	{ "spinners": { "braille": { "frames": ["⣾", "⣽", "⣻", "⢿"], "fps": 12 } } }
+package spinners
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/gum/internal/config"
+)
+
+// Spinners maps names to the built-in spinners.
+var Spinners = map[string]spinner.Spinner{
+	"line":      spinner.Line,
+	"dot":       spinner.Dot,
+	"minidot":   spinner.MiniDot,
+	"jump":      spinner.Jump,
+	"pulse":     spinner.Pulse,
+	"points":    spinner.Points,
+	"globe":     spinner.Globe,
+	"moon":      spinner.Moon,
+	"monkey":    spinner.Monkey,
+	"meter":     spinner.Meter,
+	"hamburger": spinner.Hamburger,
+}
+
+// defaultFPS is the frame rate of custom spinners that do not set one.
+const defaultFPS = 10
+
+// Definition is a spinner defined in the configuration file.
+type Definition struct {
+	Frames []string `json:"frames"`
+	FPS    int      `json:"fps"`
+}
+
+// Custom returns a spinner cycling through the given frames at the given
+// number of frames per second.
+func Custom(frames []string, fps int) spinner.Spinner {
+	if fps <= 0 {
+		fps = defaultFPS
+	}
+	return spinner.Spinner{
+		Frames: frames,
+		FPS:    time.Second / time.Duration(fps),
+	}
+}
+
+// Get returns the spinner with the given name, either built-in or defined in
+// the configuration file.
+func Get(name string) (spinner.Spinner, error) {
+	if s, ok := Spinners[name]; ok {
+		return s, nil
+	}
+	definitions := map[string]Definition{}
+	if err := config.Load("spinners", &definitions); err != nil {
+		return spinner.Spinner{}, err
+	}
+	d, ok := definitions[name]
+	if !ok || len(d.Frames) == 0 {
+		names := slices.Sorted(maps.Keys(Spinners))
+		return spinner.Spinner{}, fmt.Errorf("unknown spinner %q, available spinners: %v", name, names)
+	}
+	return Custom(d.Frames, d.FPS), nil
+}
+
+// Resolve returns the spinner to use given a spinner name and optional custom
+// frames and frame rate. Custom frames take precedence over the name.
+func Resolve(name string, frames []string, fps int) (spinner.Spinner, error) {
+	if len(frames) > 0 {
+		return Custom(frames, fps), nil
+	}
+	s, err := Get(name)
+	if err != nil {
+		return s, err
+	}
+	if fps > 0 {
+		s.FPS = time.Second / time.Duration(fps)
+	}
+	return s, nil
+}
@@ -0,0 +1,49 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/gum/internal/accessible"
+)
+
+// describe returns the cells of the row named by their column, such as
+// "Flavor: Banana, Price: $0.99".
+func describe(columns []string, row table.Row) string {
+	cells := make([]string, len(row))
+	for i, cell := range row {
+		cells[i] = columns[i] + ": " + cell
+	}
+	return strings.Join(cells, ", ")
+}
+
+// ask lists the rows numbered, and reads the number of the selected one.
+// Nothing typed selects the first row.
+func ask(columns []string, rows []table.Row) (table.Row, error) {
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	for i, row := range rows {
+		accessible.Println(accessible.Item(i+1, describe(columns, row)))
+	}
+	prompt := fmt.Sprintf("Choose from 1 to %d [1]: ", len(rows))
+	for {
+		line, err := accessible.ReadLine(prompt)
+		if err != nil {
+			return nil, err
+		}
+		if strings.TrimSpace(line) == "" {
+			return rows[0], nil
+		}
+		indices, err := accessible.ParseNumbers(line, len(rows), 1)
+		if err == nil && len(indices) == 0 {
+			err = fmt.Errorf("expected a number from 1 to %d", len(rows))
+		}
+		if err != nil {
+			accessible.Println(err.Error())
+			continue
+		}
+		return rows[indices[0]], nil
+	}
+}
@@ -8,6 +8,9 @@ import (
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/table"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/gum/internal/accessible"
+	"github.com/charmbracelet/gum/internal/bindings"
+	"github.com/charmbracelet/gum/internal/mouse"
 	"github.com/charmbracelet/gum/internal/stdin"
 	"github.com/charmbracelet/gum/internal/timeout"
 	"github.com/charmbracelet/gum/style"
@@ -109,6 +112,12 @@ func (o Options) Run() error {
 		rows = append(rows, table.Row(data[row]))
 	}
 
+	if o.Print && accessible.On() {
+		for _, row := range rows {
+			fmt.Println(describe(columnNames, row))
+		}
+		return nil
+	}
 	if o.Print {
 		table := ltable.New().
 			Headers(columnNames...).
@@ -126,6 +135,14 @@ func (o Options) Run() error {
 		return nil
 	}
 
+	if accessible.On() {
+		row, err := ask(columnNames, rows)
+		if err != nil {
+			return err
+		}
+		return o.write(writer, row)
+	}
+
 	opts := []table.Option{
 		table.WithColumns(columns),
 		table.WithFocused(true),
@@ -147,12 +164,22 @@ func (o Options) Run() error {
 		hideCount: o.HideCount,
 		help:      help.New(),
 		keymap:    defaultKeymap(),
+		styles:    styles,
 	}
-	tm, err := tea.NewProgram(
-		m,
+	if err := bindings.Remap("table", o.Keymap, &m.keymap); err != nil {
+		return err
+	}
+	m.table.KeyMap = m.keymap.KeyMap
+
+	options := []tea.ProgramOption{
 		tea.WithOutput(os.Stderr),
 		tea.WithContext(ctx),
-	).Run()
+	}
+	if o.Mouse {
+		m.mouse = mouse.New()
+		options = append(options, tea.WithMouseCellMotion())
+	}
+	tm, err := tea.NewProgram(m, options...).Run()
 	if err != nil {
 		return fmt.Errorf("failed to start tea program: %w", err)
 	}
@@ -162,12 +189,17 @@ func (o Options) Run() error {
 	}
 
 	m = tm.(model)
-	if o.ReturnColumn > 0 && o.ReturnColumn <= len(m.selected) {
-		if err = writer.Write([]string{m.selected[o.ReturnColumn-1]}); err != nil {
+	return o.write(writer, m.selected)
+}
+
+// write writes the selected row, or its return column.
+func (o Options) write(writer *csv.Writer, row table.Row) error {
+	if o.ReturnColumn > 0 && o.ReturnColumn <= len(row) {
+		if err := writer.Write([]string{row[o.ReturnColumn-1]}); err != nil {
 			return fmt.Errorf("failed to write col %d of selected row: %w", o.ReturnColumn, err)
 		}
 	} else {
-		if err = writer.Write([]string(m.selected)); err != nil {
+		if err := writer.Write([]string(row)); err != nil {
 			return fmt.Errorf("failed to write selected row: %w", err)
 		}
 	}
@@ -0,0 +1,66 @@
+package table
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// cursorMarker marks the row of the cursor, to find its line in the view.
+const cursorMarker = "\uE000"
+
+// updateMouse moves the cursor with the wheel, and to the row clicked. The
+// row under the cursor is selected when clicked.
+func (m model) updateMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	if msg.Action != tea.MouseActionPress {
+		return m, nil
+	}
+	switch msg.Button {
+	case tea.MouseButtonWheelDown:
+		m.table.MoveDown(1)
+	case tea.MouseButtonWheelUp:
+		m.table.MoveUp(1)
+	case tea.MouseButtonLeft:
+		row := m.rowAt(m.mouse.Line(msg))
+		cursor := m.table.Cursor()
+		switch {
+		case row < 0:
+		case row == cursor:
+			m.selected = m.table.SelectedRow()
+			m.quitting = true
+			return m, tea.Quit
+		case row > cursor:
+			m.table.MoveDown(row - cursor)
+		default:
+			m.table.MoveUp(cursor - row)
+		}
+	}
+	return m, nil
+}
+
+// rowAt returns the row shown on the given line of the view, or -1.
+//
+// The rows shown depend on how the table scrolled, so the line of the cursor
+// is found by rendering the table with the cursor marked.
+func (m model) rowAt(line int) int {
+	t := m.table
+	styles := m.styles
+	styles.Selected = styles.Selected.Transform(func(s string) string {
+		return cursorMarker + s
+	})
+	t.SetStyles(styles)
+
+	lines := strings.Split(t.View(), "\n")
+	if line < len(lines)-t.Height() || line >= len(lines) {
+		return -1
+	}
+	for i, l := range lines {
+		if strings.Contains(l, cursorMarker) {
+			if row := t.Cursor() + line - i; row < len(t.Rows()) {
+				return row
+			}
+			return -1
+		}
+	}
+	return -1
+}
@@ -22,10 +22,12 @@ import (
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/table"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/gum/internal/bindings"
+	"github.com/charmbracelet/gum/internal/mouse"
 )
 
 type keymap struct {
-	Navigate,
+	table.KeyMap
 	Select,
 	Quit,
 	Abort key.Binding
@@ -37,7 +39,7 @@ func (k keymap) FullHelp() [][]key.Binding { return nil }
 // ShortHelp implements help.KeyMap.
 func (k keymap) ShortHelp() []key.Binding {
 	return []key.Binding{
-		k.Navigate,
+		bindings.Help("navigate", k.LineDown, k.LineUp),
 		k.Select,
 		k.Quit,
 	}
@@ -45,10 +47,7 @@ func (k keymap) ShortHelp() []key.Binding {
 
 func defaultKeymap() keymap {
 	return keymap{
-		Navigate: key.NewBinding(
-			key.WithKeys("up", "down"),
-			key.WithHelp("↓↑", "navigate"),
-		),
+		KeyMap: table.DefaultKeyMap(),
 		Select: key.NewBinding(
 			key.WithKeys("enter"),
 			key.WithHelp("enter", "select"),
@@ -72,6 +71,11 @@ type model struct {
 	hideCount bool
 	help      help.Model
 	keymap    keymap
+
+	// styles are those of the table, and mouse locates the view to map
+	// clicks to rows, if the mouse is enabled.
+	styles table.Styles
+	mouse  *mouse.Tracker
 }
 
 func (m model) Init() tea.Cmd { return nil }
@@ -93,7 +97,10 @@ func (m model) countView() string {
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
 
+	m.mouse.Update(msg)
 	switch msg := msg.(type) {
+	case tea.MouseMsg:
+		return m.updateMouse(msg)
 	case tea.KeyMsg:
 		km := m.keymap
 		switch {
@@ -122,7 +129,7 @@ func (m model) View() string {
 	if m.showHelp {
 		s += "\n" + m.countView() + m.help.View(m.keymap)
 	}
-	return s
+	return m.mouse.Render(s)
 }
 
 func numLen(i int) int {
@@ -3,7 +3,10 @@ package tickwait
 import "time"
 
 type Options struct {
-	Timeout   time.Duration `help:"Timeout of the tick wait"`
-	TimeoutFn func(cost time.Duration, view string)
-	DoneFn    func(cost time.Duration, view string, result string)
+	Timeout       time.Duration `help:"Timeout of the tick wait"`
+	Spinner       string        `help:"Spinner type (points if empty)"`
+	SpinnerFrames []string      `help:"Custom spinner frames, overrides the spinner type"`
+	SpinnerFPS    int           `help:"Spinner frames per second (0 for the spinner's default)"`
+	TimeoutFn     func(cost time.Duration, view string)
+	DoneFn        func(cost time.Duration, view string, result string)
 }
@@ -8,6 +8,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/gum/bingoo"
 	"github.com/charmbracelet/gum/internal/timeout"
+	"github.com/charmbracelet/gum/spinners"
 )
 
 type model struct {
@@ -18,9 +19,9 @@ type model struct {
 	start  time.Time
 }
 
-func initialModel() model {
+func initialModel(sp spinner.Spinner) model {
 	s := spinner.New()
-	s.Spinner = spinner.Points
+	s.Spinner = sp
 	return model{
 		spinner: s,
 		done:    false,
@@ -71,10 +72,18 @@ func (m model) View() string {
 }
 
 func (o Options) RunBingoo() (string, error) {
+	if o.Spinner == "" {
+		o.Spinner = "points"
+	}
+	sp, err := spinners.Resolve(o.Spinner, o.SpinnerFrames, o.SpinnerFPS)
+	if err != nil {
+		return "", err
+	}
+
 	ctx, cancel := timeout.Context(o.Timeout)
 	defer cancel()
 
-	m := initialModel()
+	m := initialModel(sp)
 	p := tea.NewProgram(m, tea.WithContext(ctx))
 	tm, err := p.Run()
 	m = tm.(model)
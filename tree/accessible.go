@@ -0,0 +1,77 @@
+package tree
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/gum/internal/accessible"
+)
+
+// ask lists the paths of the tree numbered, and reads the numbers of the
+// picked ones. Nothing typed picks the selected paths, or the one under the
+// cursor.
+func (m model) ask() ([]string, error) {
+	if m.header != "" {
+		accessible.Println(m.header)
+	}
+	var nodes []*node
+	m.root.walk(func(n *node) {
+		if n == m.root {
+			return
+		}
+		nodes = append(nodes, n)
+		line := accessible.Item(len(nodes), n.path)
+		if m.limit > 1 && n.state() == all {
+			line += " (selected)"
+		}
+		accessible.Println(line)
+	})
+
+	var prompt string
+	if m.limit == 1 {
+		prompt = fmt.Sprintf("Choose from 1 to %d [%d]: ", len(nodes), slices.Index(nodes, m.current())+1)
+	} else {
+		prompt = fmt.Sprintf("Choose up to %d paths from 1 to %d, separated by spaces: ", min(m.limit, countLeaves(m.root)), len(nodes))
+	}
+
+	for {
+		line, err := accessible.ReadLine(prompt)
+		if err != nil {
+			return nil, err
+		}
+		if strings.TrimSpace(line) == "" {
+			if paths := m.selectedPaths(); len(paths) > 0 {
+				return paths, nil
+			}
+			return []string{m.current().path}, nil
+		}
+		indices, err := accessible.ParseNumbers(line, len(nodes), len(nodes))
+		if err == nil && m.limit == 1 && len(indices) > 1 {
+			err = fmt.Errorf("expected one number, got %d", len(indices))
+		}
+		if err != nil {
+			accessible.Println(err.Error())
+			continue
+		}
+		m.setSelected(m.root, false)
+		for _, i := range indices {
+			m.setSelected(nodes[i], true)
+		}
+		if paths := m.selectedPaths(); len(paths) <= m.limit {
+			return paths, nil
+		}
+		accessible.Println(fmt.Sprintf("expected at most %d paths, got %d", m.limit, len(m.selectedPaths())))
+	}
+}
+
+// countLeaves returns the number of leaves under the node.
+func countLeaves(n *node) int {
+	count := 0
+	n.walk(func(c *node) {
+		if c.leaf() {
+			count++
+		}
+	})
+	return count
+}
@@ -0,0 +1,52 @@
+package tree
+
+import (
+	"time"
+
+	"github.com/charmbracelet/gum/bingoo"
+)
+
+func Timeout(timeout time.Duration) func(*Options) {
+	return func(o *Options) { o.Timeout = timeout }
+}
+
+func Limit(limit int) func(*Options) {
+	return func(o *Options) { o.Limit = limit }
+}
+
+func Header(header string) func(*Options) {
+	return func(o *Options) { o.Header = header }
+}
+
+// Format sets the format of the entries: auto, path, indent or json.
+func Format(format string) func(*Options) {
+	return func(o *Options) { o.Format = format }
+}
+
+// Separator sets the separator of the path elements.
+func Separator(separator string) func(*Options) {
+	return func(o *Options) { o.Separator = separator }
+}
+
+// Topmost picks selected branches as such, instead of their leaves.
+func Topmost() func(*Options) {
+	return func(o *Options) { o.Output = "topmost" }
+}
+
+// ID is the key of the prompt in the answers file, instead of the header.
+func ID(id string) func(*Options) {
+	return func(o *Options) { o.ID = id }
+}
+
+// Tree shows the tree of the entries, and returns the picked paths.
+func Tree(entries []string, optionsFn ...func(*Options)) ([]string, error) {
+	option := &Options{}
+	bingoo.KongParse(option, bingoo.KongVars)
+
+	for _, fn := range optionsFn {
+		fn(option)
+	}
+	option.Entries = entries
+
+	return option.RunBingoo()
+}
@@ -0,0 +1,187 @@
+package tree
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/gum/internal/accessible"
+	"github.com/charmbracelet/gum/internal/answer"
+	"github.com/charmbracelet/gum/internal/bindings"
+	"github.com/charmbracelet/gum/internal/mouse"
+	"github.com/charmbracelet/gum/internal/stdin"
+	"github.com/charmbracelet/gum/internal/timeout"
+	"github.com/charmbracelet/gum/internal/tty"
+)
+
+// Run provides a shell script interface for picking paths of a tree.
+func (o Options) Run() error {
+	paths, err := o.RunBingoo()
+	if err != nil {
+		return err
+	}
+	tty.Println(strings.Join(paths, o.OutputDelimiter))
+	return nil
+}
+
+// RunBingoo shows the tree, and returns the picked paths.
+func (o Options) RunBingoo() ([]string, error) {
+	input := strings.Join(o.Entries, "\n")
+	if len(o.Entries) == 0 {
+		input, _ = stdin.Read(stdin.StripANSI(o.StripANSI))
+	}
+	root, err := parse(input, o.Format, o.Separator)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.NoLimit {
+		o.Limit = math.MaxInt
+	}
+	if o.Limit <= 1 {
+		o.SelectedPrefix, o.PartialPrefix, o.UnselectedPrefix = "", "", ""
+	}
+
+	km := defaultKeymap()
+	if err := bindings.Remap("tree", o.Keymap, &km); err != nil {
+		return nil, err
+	}
+	if o.Limit > 1 {
+		km.Toggle.SetEnabled(true)
+	}
+	search := textinput.New()
+	search.Prompt = "/"
+	search.PromptStyle = o.CursorStyle.ToLipgloss()
+
+	m := model{
+		root:             root,
+		height:           max(1, o.Height),
+		limit:            o.Limit,
+		topmost:          o.Output == "topmost",
+		header:           o.Header,
+		cursor:           o.Cursor,
+		showHelp:         o.ShowHelp,
+		help:             help.New(),
+		keymap:           km,
+		search:           search,
+		selectedPrefix:   o.SelectedPrefix,
+		partialPrefix:    o.PartialPrefix,
+		unselectedPrefix: o.UnselectedPrefix,
+		cursorStyle:      o.CursorStyle.ToLipgloss(),
+		headerStyle:      o.HeaderStyle.ToLipgloss(),
+		branchStyle:      o.BranchStyle.ToLipgloss(),
+		itemStyle:        o.ItemStyle.ToLipgloss(),
+		selectedStyle:    o.SelectedStyle.ToLipgloss(),
+		matchStyle:       o.MatchStyle.ToLipgloss(),
+	}
+
+	var paths []string
+	var first *node
+	root.walk(func(n *node) {
+		if n == root {
+			return
+		}
+		paths = append(paths, n.path)
+		n.expanded = o.Expand
+		if !slices.Contains(o.Selected, n.path) {
+			return
+		}
+		if first == nil {
+			first = n
+		}
+		if o.Limit > 1 {
+			m.setSelected(n, true)
+		}
+	})
+	// The tree is expanded down to the first selected path, which is under
+	// the cursor.
+	if first != nil {
+		for p := first.parent; p != nil; p = p.parent {
+			p.expanded = true
+		}
+	}
+	m.refresh()
+	for i, r := range m.rows {
+		if r.node == first {
+			m.index = i
+		}
+	}
+	m.scroll()
+
+	prompt := answer.Prompt{Command: "tree", ID: answer.Key(o.ID, o.Header), Options: paths}
+	if picked, ok, err := m.answer(prompt); ok || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		prompt.Answer = picked
+		return picked, answer.Record(prompt)
+	}
+
+	if accessible.On() {
+		picked, err := m.ask()
+		if err != nil {
+			return nil, err
+		}
+		prompt.Answer = picked
+		return picked, answer.Record(prompt)
+	}
+
+	ctx, cancel := timeout.Context(o.Timeout)
+	defer cancel()
+
+	options := []tea.ProgramOption{
+		tea.WithOutput(os.Stderr),
+		tea.WithContext(ctx),
+	}
+	if o.Mouse {
+		m.mouse = mouse.New()
+		options = append(options, tea.WithMouseCellMotion())
+	}
+	tm, err := tea.NewProgram(m, options...).Run()
+	if err != nil {
+		return nil, fmt.Errorf("unable to pick paths: %w", err)
+	}
+	m = tm.(model)
+	if !m.submitted {
+		return nil, errors.New("nothing selected")
+	}
+	prompt.Answer = m.selectedPaths()
+	return prompt.Answer, answer.Record(prompt)
+}
+
+// answer picks the paths from the recording or the answers file, or when
+// assuming yes the selected paths, else the one under the cursor.
+func (m model) answer(prompt answer.Prompt) ([]string, bool, error) {
+	answers, ok, err := answer.Lookup(prompt)
+	if err != nil {
+		return nil, false, err
+	}
+	if !ok {
+		if !answer.Yes() {
+			return nil, false, nil
+		}
+		if paths := m.selectedPaths(); len(paths) > 0 {
+			return paths, true, nil
+		}
+		return []string{m.current().path}, true, nil
+	}
+
+	switch {
+	case len(answers) == 0:
+		return nil, false, fmt.Errorf("no answer to %q", prompt.ID)
+	case len(answers) > m.limit:
+		return nil, false, fmt.Errorf("expected at most %d answers to %q, got %d", m.limit, prompt.ID, len(answers))
+	}
+	for _, a := range answers {
+		if !slices.Contains(prompt.Options, a) {
+			return nil, false, fmt.Errorf("%q is not a path of %q", a, prompt.ID)
+		}
+	}
+	return answers, true, nil
+}
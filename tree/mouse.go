@@ -0,0 +1,61 @@
+package tree
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// updateMouse moves the cursor with the wheel, and handles clicks on the
+// nodes: clicking the icon of a branch expands or collapses it, and clicking
+// a node toggles it if several can be picked, and else moves the cursor to
+// it, the node under the cursor being submitted.
+func (m model) updateMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	if msg.Action != tea.MouseActionPress {
+		return m, nil
+	}
+	switch msg.Button {
+	case tea.MouseButtonWheelDown:
+		m.move(1)
+	case tea.MouseButtonWheelUp:
+		m.move(-1)
+	case tea.MouseButtonLeft:
+		i := m.rowAt(m.mouse.Line(msg))
+		if i < 0 {
+			break
+		}
+		n := m.rows[i].node
+		icon := lipgloss.Width(m.cursor) + m.rows[i].depth*lipgloss.Width(indent)
+		onIcon := msg.X >= icon && msg.X < icon+lipgloss.Width(collapsedIcon)
+		switch {
+		case onIcon && !n.leaf():
+			m.index = i
+			if n.expanded {
+				m.collapse()
+			} else {
+				m.expand()
+			}
+		case m.limit <= 1 && i == m.index:
+			return m.submit()
+		default:
+			m.index = i
+			m.toggle()
+		}
+	}
+	m.scroll()
+	return m, nil
+}
+
+// rowAt returns the index of the row shown on the given line of the view,
+// or -1.
+func (m model) rowAt(line int) int {
+	if m.header != "" {
+		line -= lipgloss.Height(m.headerStyle.Render(m.header))
+	}
+	if m.searching || m.search.Value() != "" {
+		line--
+	}
+	if line < 0 || line >= min(m.height, len(m.rows)-m.offset) {
+		return -1
+	}
+	return m.offset + line
+}
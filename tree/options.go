@@ -0,0 +1,41 @@
+package tree
+
+import (
+	"time"
+
+	"github.com/charmbracelet/gum/style"
+)
+
+// Options are the customization options for the tree command.
+type Options struct {
+	Entries   []string `arg:"" optional:"" help:"Entries of the tree (can also be passed via stdin)"`
+	Format    string   `help:"Format of the entries: paths, indented lines or JSON objects and arrays" enum:"auto,path,indent,json" default:"auto" env:"GUM_TREE_FORMAT"`
+	Separator string   `help:"Separator of the path elements" default:"/" env:"GUM_TREE_SEPARATOR"`
+	Expand    bool     `help:"Start with all the branches expanded" default:"false" env:"GUM_TREE_EXPAND"`
+
+	Limit            int      `help:"Maximum number of paths to pick" default:"1" group:"Selection"`
+	NoLimit          bool     `help:"Pick unlimited number of paths (ignores limit)" group:"Selection"`
+	Selected         []string `help:"Paths that should start as selected, with their descendants" default:"" env:"GUM_TREE_SELECTED" group:"Selection"`
+	Output           string   `help:"Paths printed for a selected branch: its leaves, or the branch itself" enum:"leaves,topmost" default:"leaves" env:"GUM_TREE_OUTPUT" group:"Selection"`
+	OutputDelimiter  string   `help:"Path delimiter when writing to STDOUT" default:"\n" env:"GUM_TREE_OUTPUT_DELIMITER" group:"Selection"`
+	SelectedPrefix   string   `help:"Prefix to show on selected paths (hidden if limit is 1)" default:"✓ " env:"GUM_TREE_SELECTED_PREFIX" group:"Selection"`
+	PartialPrefix    string   `help:"Prefix to show on partly selected branches (hidden if limit is 1)" default:"◐ " env:"GUM_TREE_PARTIAL_PREFIX" group:"Selection"`
+	UnselectedPrefix string   `help:"Prefix to show on unselected paths (hidden if limit is 1)" default:"• " env:"GUM_TREE_UNSELECTED_PREFIX" group:"Selection"`
+
+	Header    string            `help:"Header value" default:"" env:"GUM_TREE_HEADER"`
+	ID        string            `help:"Id of the prompt in the answers file (defaults to the header)" default:""`
+	Height    int               `help:"Height of the list" default:"10" env:"GUM_TREE_HEIGHT"`
+	Cursor    string            `help:"Prefix to show on the path under the cursor" default:"> " env:"GUM_TREE_CURSOR"`
+	ShowHelp  bool              `help:"Show help keybinds" default:"true" negatable:"" env:"GUM_TREE_SHOW_HELP"`
+	Keymap    map[string]string `help:"Remap key bindings, as action=keys (e.g. down=ctrl+j,down)" placeholder:"action=keys;..." env:"GUM_TREE_KEYMAP"`
+	Mouse     bool              `help:"Enable the mouse, to click and scroll with the wheel" env:"GUM_TREE_MOUSE"`
+	Timeout   time.Duration     `help:"Timeout until tree returns" default:"0s" env:"GUM_TREE_TIMEOUT"`
+	StripANSI bool              `help:"Strip ANSI sequences when reading from STDIN" default:"true" negatable:"" env:"GUM_TREE_STRIP_ANSI"`
+
+	CursorStyle   style.Styles `embed:"" prefix:"cursor." set:"defaultForeground=212" envprefix:"GUM_TREE_CURSOR_"`
+	HeaderStyle   style.Styles `embed:"" prefix:"header." set:"defaultForeground=99" envprefix:"GUM_TREE_HEADER_"`
+	BranchStyle   style.Styles `embed:"" prefix:"branch." set:"defaultForeground=99" envprefix:"GUM_TREE_BRANCH_"`
+	ItemStyle     style.Styles `embed:"" prefix:"item." hidden:"" envprefix:"GUM_TREE_ITEM_"`
+	SelectedStyle style.Styles `embed:"" prefix:"selected." set:"defaultForeground=212" envprefix:"GUM_TREE_SELECTED_"`
+	MatchStyle    style.Styles `embed:"" prefix:"match." set:"defaultForeground=212" set:"defaultBold=true" envprefix:"GUM_TREE_MATCH_"` //nolint:staticcheck
+}
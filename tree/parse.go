@@ -0,0 +1,215 @@
+package tree
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// node is an entry of the tree.
+type node struct {
+	name string
+	// path is the path of the node, as given or joined with the separator.
+	path     string
+	parent   *node
+	children []*node
+	expanded bool
+	// selected is only set on leaves, branches are selected with all their
+	// leaves.
+	selected bool
+}
+
+// child returns the child with the name, adding it if needed.
+func (n *node) child(name, path string) *node {
+	for _, c := range n.children {
+		if c.name == name {
+			return c
+		}
+	}
+	c := &node{name: name, path: path, parent: n}
+	n.children = append(n.children, c)
+	return c
+}
+
+// leaf reports whether the node has no children.
+func (n *node) leaf() bool {
+	return len(n.children) == 0
+}
+
+// walk calls fn on the node and its descendants, depth first.
+func (n *node) walk(fn func(*node)) {
+	fn(n)
+	for _, c := range n.children {
+		c.walk(fn)
+	}
+}
+
+// parse reads the tree in the format, detecting it if it is "auto".
+func parse(input, format, separator string) (*node, error) {
+	if format == "auto" {
+		format = detect(input)
+	}
+	if separator == "" {
+		return nil, errors.New("the separator cannot be empty")
+	}
+	root := &node{expanded: true}
+	var err error
+	switch format {
+	case "json":
+		err = parseJSON(root, input, separator)
+	case "indent":
+		err = parseIndented(root, input, separator)
+	default:
+		parsePaths(root, input, separator)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid tree: %w", err)
+	}
+	if root.leaf() {
+		return nil, errors.New("no entries provided, see `gum tree --help`")
+	}
+	return root, nil
+}
+
+// detect returns the format of the input: JSON if it starts like it, else
+// indented text if a line is indented, else paths.
+func detect(input string) string {
+	trimmed := strings.TrimSpace(input)
+	if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		return "json"
+	}
+	for _, line := range strings.Split(input, "\n") {
+		if strings.TrimSpace(line) != "" && unicode.IsSpace(rune(line[0])) {
+			return "indent"
+		}
+	}
+	return "path"
+}
+
+// parsePaths reads a path per line, such as a/b/c. Nodes keep the path they
+// were given with, leading separator included.
+func parsePaths(root *node, input, separator string) {
+	for _, line := range strings.Split(input, "\n") {
+		line = strings.TrimRight(line, "\r")
+		n := root
+		end := 0
+		for _, name := range strings.Split(line, separator) {
+			end += len(name)
+			if name != "" {
+				n = n.child(name, line[:end])
+			}
+			end += len(separator)
+		}
+	}
+}
+
+// parseIndented reads a node per line, indented under its parent. Siblings
+// must be indented the same, and children further than their parent.
+func parseIndented(root *node, input, separator string) error {
+	type level struct {
+		indent string
+		node   *node
+	}
+	stack := []level{{node: root}}
+	for i, line := range strings.Split(input, "\n") {
+		name := strings.TrimSpace(line)
+		if name == "" {
+			continue
+		}
+		indent := line[:len(line)-len(strings.TrimLeftFunc(line, unicode.IsSpace))]
+		top := len(stack) - 1
+		for top > 0 && len(stack[top].indent) >= len(indent) {
+			top--
+		}
+		// A sibling of a popped node must be indented like it, and a child
+		// like its parent and then some.
+		if top+1 < len(stack) && stack[top+1].indent != indent ||
+			top > 0 && !strings.HasPrefix(indent, stack[top].indent) {
+			return fmt.Errorf("line %d: inconsistent indentation", i+1)
+		}
+		stack = stack[:top+1]
+		parent := stack[top].node
+		n := parent.child(name, join(parent, name, separator))
+		stack = append(stack, level{indent: indent, node: n})
+	}
+	return nil
+}
+
+// parseJSON reads objects, whose keys are nodes, and arrays, whose strings
+// are leaves, keeping the order of the document.
+func parseJSON(root *node, input, separator string) error {
+	d := json.NewDecoder(strings.NewReader(input))
+	d.UseNumber()
+	for {
+		err := decodeValue(d, root, separator, false)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// decodeValue reads the next value under the parent. Strings are leaves,
+// unless they are the value of a key, and other values are errors.
+func decodeValue(d *json.Decoder, parent *node, separator string, value bool) error {
+	tok, err := d.Token()
+	if err != nil {
+		return err
+	}
+	switch tok {
+	case json.Delim('{'):
+		for d.More() {
+			tok, err := d.Token()
+			if err != nil {
+				return unexpected(err)
+			}
+			name, _ := tok.(string)
+			n := parent.child(name, join(parent, name, separator))
+			if err := decodeValue(d, n, separator, true); err != nil {
+				return unexpected(err)
+			}
+		}
+		_, err = d.Token()
+		return unexpected(err)
+	case json.Delim('['):
+		for d.More() {
+			if err := decodeValue(d, parent, separator, false); err != nil {
+				return unexpected(err)
+			}
+		}
+		_, err = d.Token()
+		return unexpected(err)
+	}
+	name, ok := tok.(string)
+	if !ok {
+		if tok == nil {
+			tok = "null"
+		}
+		return fmt.Errorf("unexpected %v, expected a string, an object or an array", tok)
+	}
+	if !value {
+		parent.child(name, join(parent, name, separator))
+	}
+	return nil
+}
+
+// unexpected turns the end of the input within a value into an error.
+func unexpected(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// join returns the path of the child of the node with the name.
+func join(parent *node, name, separator string) string {
+	if parent.parent == nil {
+		return name
+	}
+	return parent.path + separator + name
+}
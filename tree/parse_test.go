@@ -0,0 +1,189 @@
+package tree
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// paths lists the paths of the nodes, depth first, indented by depth.
+func paths(root *node) []string {
+	var out []string
+	var walk func(n *node, depth int)
+	walk = func(n *node, depth int) {
+		for _, c := range n.children {
+			out = append(out, strings.Repeat("  ", depth)+c.path)
+			walk(c, depth+1)
+		}
+	}
+	walk(root, 0)
+	return out
+}
+
+func TestParse(t *testing.T) {
+	for name, tt := range map[string]struct {
+		format    string
+		separator string
+		input     string
+		out       []string
+		err       string
+	}{
+		"paths": {
+			input: "a/b\na/c\nd",
+			out:   []string{"a", "  a/b", "  a/c", "d"},
+		},
+		"paths with a leading separator": {
+			input: "/etc/hosts\n/etc/passwd",
+			out:   []string{"/etc", "  /etc/hosts", "  /etc/passwd"},
+		},
+		"paths with another separator": {
+			separator: "::",
+			input:     "a::b\na::c",
+			out:       []string{"a", "  a::b", "  a::c"},
+		},
+		"paths with repeated separators": {
+			input: "a//b/\n",
+			out:   []string{"a", "  a//b"},
+		},
+		"indented": {
+			format: "indent",
+			input:  "a\n  b\n    c\n  d\ne",
+			out:    []string{"a", "  a/b", "    a/b/c", "  a/d", "e"},
+		},
+		"indented with tabs": {
+			format: "indent",
+			input:  "a\n\tb\n\t\tc\nd",
+			out:    []string{"a", "  a/b", "    a/b/c", "d"},
+		},
+		"indented with blank lines": {
+			format: "indent",
+			input:  "a\n\n  b\n   \n  c\n",
+			out:    []string{"a", "  a/b", "  a/c"},
+		},
+		"indented all": {
+			format: "indent",
+			input:  "  a\n    b\n  c",
+			out:    []string{"a", "  a/b", "c"},
+		},
+		"indented back between levels": {
+			format: "indent",
+			input:  "a\n  b\n c",
+			err:    "invalid tree: line 3: inconsistent indentation",
+		},
+		"indented back before the first": {
+			format: "indent",
+			input:  "  a\nb",
+			err:    "invalid tree: line 2: inconsistent indentation",
+		},
+		"indented with tabs and spaces": {
+			format: "indent",
+			input:  "a\n\tb\n  c",
+			err:    "invalid tree: line 3: inconsistent indentation",
+		},
+		"json objects and arrays": {
+			format: "json",
+			input:  `{"a": {"b": ["c", "d"]}, "e": []}`,
+			out:    []string{"a", "  a/b", "    a/b/c", "    a/b/d", "e"},
+		},
+		"json keeps the order": {
+			format: "json",
+			input:  `{"z": [], "a": []}`,
+			out:    []string{"z", "a"},
+		},
+		"json string values": {
+			format: "json",
+			input:  `{"a": "description"}`,
+			out:    []string{"a"},
+		},
+		"json array": {
+			format: "json",
+			input:  `["a", {"b": ["c"]}]`,
+			out:    []string{"a", "b", "  b/c"},
+		},
+		"json numbers": {
+			format: "json",
+			input:  `{"a": [1, 2]}`,
+			err:    "invalid tree: unexpected 1, expected a string, an object or an array",
+		},
+		"json booleans": {
+			format: "json",
+			input:  `["a", true]`,
+			err:    "invalid tree: unexpected true, expected a string, an object or an array",
+		},
+		"json null": {
+			format: "json",
+			input:  `{"a": null}`,
+			err:    "invalid tree: unexpected null, expected a string, an object or an array",
+		},
+		"json invalid": {
+			format: "json",
+			input:  `{"a": `,
+			err:    "invalid tree: unexpected EOF",
+		},
+		"detected json": {
+			input: `  {"a": ["b"]}`,
+			out:   []string{"a", "  a/b"},
+		},
+		"detected indented": {
+			input: "a\n  b",
+			out:   []string{"a", "  a/b"},
+		},
+		"empty": {
+			input: "\n\n",
+			err:   "no entries provided",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			format := tt.format
+			if format == "" {
+				format = "auto"
+			}
+			separator := tt.separator
+			if separator == "" {
+				separator = "/"
+			}
+			root, err := parse(tt.input, format, separator)
+			if tt.err != "" {
+				if err == nil || !strings.HasPrefix(err.Error(), tt.err) {
+					t.Fatalf("expected error %q, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := paths(root); !slices.Equal(got, tt.out) {
+				t.Errorf("expected %q, got %q", tt.out, got)
+			}
+		})
+	}
+}
+
+func TestParseEmptySeparator(t *testing.T) {
+	for _, format := range []string{"path", "indent", "json"} {
+		if _, err := parse("a/b", format, ""); err == nil {
+			t.Errorf("expected an error with an empty separator for %s", format)
+		}
+	}
+}
+
+func TestDetect(t *testing.T) {
+	for input, format := range map[string]string{
+		`{"a": []}`:        "json",
+		"\n  [\"a\"]":      "json",
+		"a/b\nc":           "path",
+		"a\n  b":           "indent",
+		"a\n\tb":           "indent",
+		"a\n\n":            "path",
+		"  a":              "indent",
+		"a{b}\n":           "path",
+		"a [b]\nc":         "path",
+		"":                 "path",
+		"a\r\nb\r\n":       "path",
+		"a b/c d\n e f/g ": "indent",
+	} {
+		if got := detect(input); got != format {
+			t.Errorf("expected %s for %q, got %s", format, input, got)
+		}
+	}
+}
@@ -0,0 +1,549 @@
+// Package tree provides an interface to pick entries of a tree, such as
+// nested configuration keys, namespaces and their pods, or packages and
+// their targets.
+//
+// The tree is read as paths, indented lines or JSON, and the picked paths
+// are printed.
+//
+// $ git ls-files | gum tree --no-limit
+package tree
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/gum/internal/bindings"
+	"github.com/charmbracelet/gum/internal/mouse"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sahilm/fuzzy"
+)
+
+const (
+	indent        = "  "
+	expandedIcon  = "▾ "
+	collapsedIcon = "▸ "
+	leafIcon      = "  "
+)
+
+func defaultKeymap() keymap {
+	return keymap{
+		Down: key.NewBinding(
+			key.WithKeys("down", "j", "ctrl+j", "ctrl+n"),
+		),
+		Up: key.NewBinding(
+			key.WithKeys("up", "k", "ctrl+k", "ctrl+p"),
+		),
+		Expand: key.NewBinding(
+			key.WithKeys("right", "l"),
+		),
+		Collapse: key.NewBinding(
+			key.WithKeys("left", "h"),
+		),
+		Home: key.NewBinding(
+			key.WithKeys("g", "home"),
+		),
+		End: key.NewBinding(
+			key.WithKeys("G", "end"),
+		),
+		Toggle: key.NewBinding(
+			key.WithKeys(" ", "tab", "x"),
+			key.WithHelp("x", "toggle"),
+			key.WithDisabled(),
+		),
+		Search: key.NewBinding(
+			key.WithKeys("/"),
+			key.WithHelp("/", "search"),
+		),
+		Abort: key.NewBinding(
+			key.WithKeys("ctrl+c"),
+			key.WithHelp("ctrl+c", "abort"),
+		),
+		Quit: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "quit"),
+		),
+		Submit: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "submit"),
+		),
+	}
+}
+
+type keymap struct {
+	Down,
+	Up,
+	Expand,
+	Collapse,
+	Home,
+	End,
+	Toggle,
+	Search,
+	Abort,
+	Quit,
+	Submit key.Binding
+}
+
+// FullHelp implements help.KeyMap.
+func (k keymap) FullHelp() [][]key.Binding { return nil }
+
+// ShortHelp implements help.KeyMap.
+func (k keymap) ShortHelp() []key.Binding {
+	return []key.Binding{
+		bindings.Help("navigate", k.Down, k.Up),
+		bindings.Help("collapse/expand", k.Collapse, k.Expand),
+		k.Toggle,
+		k.Search,
+		k.Submit,
+	}
+}
+
+// row is a node shown at a depth.
+type row struct {
+	node  *node
+	depth int
+}
+
+type model struct {
+	root     *node
+	rows     []row
+	index    int
+	offset   int
+	height   int
+	limit    int
+	topmost  bool
+	header   string
+	cursor   string
+	quitting bool
+
+	submitted bool
+	showHelp  bool
+	help      help.Model
+	keymap    keymap
+
+	selectedPrefix   string
+	partialPrefix    string
+	unselectedPrefix string
+
+	// search narrows the tree to the nodes matching it and their ancestors,
+	// which are expanded. matches are the matched bytes of the names.
+	search    textinput.Model
+	searching bool
+	matches   map[*node][]int
+	ancestors map[*node]bool
+
+	// mouse locates the view to map clicks to nodes, if the mouse is
+	// enabled.
+	mouse *mouse.Tracker
+
+	// styles
+	cursorStyle   lipgloss.Style
+	headerStyle   lipgloss.Style
+	branchStyle   lipgloss.Style
+	itemStyle     lipgloss.Style
+	selectedStyle lipgloss.Style
+	matchStyle    lipgloss.Style
+}
+
+func (m model) Init() tea.Cmd { return nil }
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	m.mouse.Update(msg)
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		return m, nil
+
+	case tea.MouseMsg:
+		return m.updateMouse(msg)
+
+	case tea.KeyMsg:
+		if m.searching {
+			return m.updateSearch(msg)
+		}
+		km := m.keymap
+		switch {
+		case key.Matches(msg, km.Down):
+			m.move(1)
+		case key.Matches(msg, km.Up):
+			m.move(-1)
+		case key.Matches(msg, km.Home):
+			m.index = 0
+		case key.Matches(msg, km.End):
+			m.index = len(m.rows) - 1
+		case key.Matches(msg, km.Expand):
+			m.expand()
+		case key.Matches(msg, km.Collapse):
+			m.collapse()
+		case key.Matches(msg, km.Toggle):
+			m.toggle()
+		case key.Matches(msg, km.Search):
+			m.searching = true
+			return m, m.search.Focus()
+		case key.Matches(msg, km.Quit):
+			// The search is cleared first.
+			if m.search.Value() != "" {
+				m.clearSearch()
+				break
+			}
+			m.quitting = true
+			return m, tea.Quit
+		case key.Matches(msg, km.Abort):
+			m.quitting = true
+			return m, tea.Interrupt
+		case key.Matches(msg, km.Submit):
+			return m.submit()
+		}
+	}
+	m.scroll()
+	return m, nil
+}
+
+// updateSearch handles the keys while the search is typed. Arrows move the
+// cursor and tab toggles, as remapped, other keys edit the search.
+func (m model) updateSearch(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	km := m.keymap
+	// Letters and space are typed, even when they are bound.
+	typed := msg.Type == tea.KeyRunes || msg.Type == tea.KeySpace
+	var cmd tea.Cmd
+	switch {
+	case key.Matches(msg, km.Abort):
+		m.quitting = true
+		return m, tea.Interrupt
+	case key.Matches(msg, km.Quit):
+		m.clearSearch()
+	case key.Matches(msg, km.Submit):
+		// The search is kept, and paths are submitted as usual.
+		m.searching = false
+		m.search.Blur()
+	case !typed && key.Matches(msg, km.Down):
+		m.move(1)
+	case !typed && key.Matches(msg, km.Up):
+		m.move(-1)
+	case !typed && key.Matches(msg, km.Toggle):
+		m.toggle()
+	default:
+		value := m.search.Value()
+		m.search, cmd = m.search.Update(msg)
+		if m.search.Value() != value {
+			m.find()
+			m.index = 0
+		}
+	}
+	m.scroll()
+	return m, cmd
+}
+
+func (m model) submit() (tea.Model, tea.Cmd) {
+	if len(m.selectedPaths()) == 0 {
+		if len(m.rows) == 0 {
+			return m, nil
+		}
+		// Without selection, the node under the cursor is picked.
+		current := m.rows[m.index].node
+		m.setSelected(current, true)
+	}
+	m.quitting = true
+	m.submitted = true
+	return m, tea.Quit
+}
+
+// current returns the node under the cursor, or nil.
+func (m model) current() *node {
+	if m.index < 0 || m.index >= len(m.rows) {
+		return nil
+	}
+	return m.rows[m.index].node
+}
+
+func (m *model) move(direction int) {
+	if n := len(m.rows); n > 0 {
+		m.index = (m.index + direction + n) % n
+	}
+}
+
+// expand expands the branch under the cursor, or moves to its first child
+// if it is expanded.
+func (m *model) expand() {
+	n := m.current()
+	switch {
+	case n == nil || n.leaf():
+	case n.expanded:
+		m.move(1)
+	default:
+		n.expanded = true
+		m.refresh()
+	}
+}
+
+// collapse collapses the branch under the cursor, or moves to its parent.
+func (m *model) collapse() {
+	n := m.current()
+	switch {
+	case n == nil:
+	case n.expanded && !n.leaf():
+		n.expanded = false
+		m.refresh()
+	case n.parent != m.root:
+		for i, r := range m.rows {
+			if r.node == n.parent {
+				m.index = i
+			}
+		}
+	}
+}
+
+// toggle selects the node under the cursor with its descendants, or
+// deselects them if they all are. Nothing is selected past the limit.
+func (m *model) toggle() {
+	n := m.current()
+	if n == nil || m.limit <= 1 {
+		return
+	}
+	selected := n.state() != all
+	m.setSelected(n, selected)
+	if selected && len(m.selectedPaths()) > m.limit {
+		m.setSelected(n, false)
+	}
+}
+
+// setSelected selects or deselects the leaves of the node.
+func (m *model) setSelected(n *node, selected bool) {
+	n.walk(func(c *node) {
+		if c.leaf() {
+			c.selected = selected
+		}
+	})
+	// A picked branch is picked as such, not as its leaves.
+	if !n.leaf() && m.limit <= 1 {
+		n.selected = selected
+	}
+}
+
+// selectedPaths returns the paths of the selected leaves, or of the topmost
+// selected nodes.
+func (m model) selectedPaths() []string {
+	var paths []string
+	var add func(n *node)
+	add = func(n *node) {
+		for _, c := range n.children {
+			switch {
+			case c.selected && !c.leaf():
+				paths = append(paths, c.path)
+			case c.leaf():
+				if c.selected {
+					paths = append(paths, c.path)
+				}
+			case m.topmost && c.state() == all:
+				paths = append(paths, c.path)
+			default:
+				add(c)
+			}
+		}
+	}
+	add(m.root)
+	return paths
+}
+
+// selection is how many leaves of a node are selected.
+type selection int
+
+const (
+	none selection = iota
+	some
+	all
+)
+
+// state returns whether none, some or all the leaves of the node are
+// selected.
+func (n *node) state() selection {
+	if n.leaf() {
+		if n.selected {
+			return all
+		}
+		return none
+	}
+	state := n.children[0].state()
+	for _, c := range n.children[1:] {
+		if c.state() != state {
+			return some
+		}
+	}
+	return state
+}
+
+// find matches the nodes against the search.
+func (m *model) find() {
+	query := m.search.Value()
+	m.matches, m.ancestors = nil, nil
+	if query != "" {
+		var nodes []*node
+		var paths []string
+		m.root.walk(func(n *node) {
+			if n != m.root {
+				nodes = append(nodes, n)
+				paths = append(paths, n.path)
+			}
+		})
+		m.matches = map[*node][]int{}
+		m.ancestors = map[*node]bool{}
+		for _, match := range fuzzy.Find(query, paths) {
+			n := nodes[match.Index]
+			// Only the characters of the name are highlighted.
+			start := len(n.path) - len(n.name)
+			var indexes []int
+			for _, i := range match.MatchedIndexes {
+				if i >= start {
+					indexes = append(indexes, i-start)
+				}
+			}
+			m.matches[n] = indexes
+			for p := n.parent; p != m.root; p = p.parent {
+				m.ancestors[p] = true
+			}
+		}
+	}
+	m.refresh()
+}
+
+// clearSearch closes the search and shows the whole tree again, expanded
+// down to the node under the cursor.
+func (m *model) clearSearch() {
+	if n := m.current(); n != nil {
+		for p := n.parent; p != nil; p = p.parent {
+			p.expanded = true
+		}
+	}
+	m.searching = false
+	m.search.Blur()
+	m.search.Reset()
+	m.find()
+}
+
+// refresh lists the rows shown, keeping the cursor on its node.
+func (m *model) refresh() {
+	current := m.current()
+	m.rows = nil
+	var add func(n *node, depth int, filtered bool)
+	add = func(n *node, depth int, filtered bool) {
+		for _, c := range n.children {
+			_, matched := m.matches[c]
+			if filtered && !matched && !m.ancestors[c] {
+				continue
+			}
+			m.rows = append(m.rows, row{node: c, depth: depth})
+			switch {
+			case filtered && m.ancestors[c]:
+				add(c, depth+1, true)
+			case c.expanded:
+				add(c, depth+1, false)
+			}
+		}
+	}
+	add(m.root, 0, m.matches != nil)
+
+	m.index = 0
+	for i, r := range m.rows {
+		if r.node == current {
+			m.index = i
+		}
+	}
+	m.scroll()
+}
+
+// scroll keeps the cursor in view.
+func (m *model) scroll() {
+	if m.index < m.offset {
+		m.offset = m.index
+	}
+	if m.index >= m.offset+m.height {
+		m.offset = m.index - m.height + 1
+	}
+	m.offset = max(0, min(m.offset, len(m.rows)-m.height))
+}
+
+func (m model) View() string {
+	if m.quitting {
+		return ""
+	}
+
+	var s strings.Builder
+	end := min(len(m.rows), m.offset+m.height)
+	for i := m.offset; i < end; i++ {
+		r := m.rows[i]
+		if i == m.index {
+			s.WriteString(m.cursorStyle.Render(m.cursor))
+		} else {
+			s.WriteString(strings.Repeat(" ", lipgloss.Width(m.cursor)))
+		}
+		s.WriteString(strings.Repeat(indent, r.depth))
+
+		switch {
+		case r.node.leaf():
+			s.WriteString(leafIcon)
+		case r.node.expanded || m.ancestors[r.node]:
+			s.WriteString(expandedIcon)
+		default:
+			s.WriteString(collapsedIcon)
+		}
+
+		style := m.itemStyle
+		if !r.node.leaf() {
+			style = m.branchStyle
+		}
+		if m.limit > 1 {
+			switch r.node.state() {
+			case all:
+				s.WriteString(m.selectedStyle.Render(m.selectedPrefix))
+				style = m.selectedStyle
+			case some:
+				s.WriteString(m.selectedStyle.Render(m.partialPrefix))
+			default:
+				s.WriteString(m.itemStyle.Render(m.unselectedPrefix))
+			}
+		}
+		if i == m.index {
+			style = m.cursorStyle
+		}
+		s.WriteString(m.highlight(r.node, style))
+		if i < end-1 {
+			s.WriteRune('\n')
+		}
+	}
+
+	var parts []string
+	if m.header != "" {
+		parts = append(parts, m.headerStyle.Render(m.header))
+	}
+	if m.searching || m.search.Value() != "" {
+		parts = append(parts, m.search.View())
+	}
+	parts = append(parts, s.String())
+	if m.showHelp {
+		parts = append(parts, "", m.help.View(m.keymap))
+	}
+	return m.mouse.Render(lipgloss.JoinVertical(lipgloss.Left, parts...))
+}
+
+// highlight renders the name of the node, with the characters matching the
+// search highlighted.
+func (m model) highlight(n *node, style lipgloss.Style) string {
+	indexes := m.matches[n]
+	if len(indexes) == 0 {
+		return style.Render(n.name)
+	}
+	matched := map[int]bool{}
+	for _, i := range indexes {
+		matched[i] = true
+	}
+	var s strings.Builder
+	for i, r := range n.name {
+		if matched[i] {
+			s.WriteString(m.matchStyle.Inherit(style).Render(string(r)))
+		} else {
+			s.WriteString(style.Render(string(r)))
+		}
+	}
+	return s.String()
+}
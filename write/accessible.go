@@ -0,0 +1,41 @@
+package write
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/charmbracelet/gum/internal/accessible"
+)
+
+// ask reads the text line by line, until a line with only a period or the
+// end of the input. Nothing typed keeps the initial text.
+func (m model) ask(initial string) (string, error) {
+	if m.header != "" {
+		accessible.Println(m.header)
+	}
+	if initial != "" {
+		accessible.Println("The text is:")
+		for _, line := range strings.Split(initial, "\n") {
+			accessible.Println(line)
+		}
+		accessible.Println("Type lines to replace it, then a line with only a period.")
+	} else {
+		accessible.Println("Type lines, then a line with only a period.")
+	}
+
+	var lines []string
+	for {
+		line, err := accessible.ReadLine("")
+		if errors.Is(err, accessible.ErrAborted) || err == nil && line == "." {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) == 0 {
+		return initial, nil
+	}
+	return strings.Join(lines, "\n"), nil
+}
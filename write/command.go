@@ -10,6 +10,9 @@ import (
 	"github.com/charmbracelet/bubbles/textarea"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/gum/cursor"
+	"github.com/charmbracelet/gum/internal/accessible"
+	"github.com/charmbracelet/gum/internal/bindings"
+	"github.com/charmbracelet/gum/internal/editor"
 	"github.com/charmbracelet/gum/internal/stdin"
 	"github.com/charmbracelet/gum/internal/timeout"
 )
@@ -46,21 +49,62 @@ func (o Options) Run() error {
 	a.Cursor.Style = o.CursorStyle.ToLipgloss()
 	a.Cursor.SetMode(cursor.Modes[o.CursorMode])
 
-	a.SetWidth(o.Width)
+	if !o.Wrap {
+		a.MaxWidth = 0
+	}
 	a.SetHeight(o.Height)
-	a.SetValue(o.Value)
+
+	if o.TabWidth < 1 {
+		return errors.New("tab width must be at least 1")
+	}
 
 	m := model{
-		textarea:    a,
-		header:      o.Header,
-		headerStyle: o.HeaderStyle.ToLipgloss(),
-		autoWidth:   o.Width < 1,
-		help:        help.New(),
-		showHelp:    o.ShowHelp,
-		keymap:      defaultKeymap(),
+		textarea:     a,
+		header:       o.Header,
+		headerStyle:  o.HeaderStyle.ToLipgloss(),
+		warningStyle: o.WarningStyle.ToLipgloss(),
+		autoWidth:    o.Width < 1,
+		width:        o.Width,
+		wrap:         o.Wrap,
+		tabWidth:     o.TabWidth,
+		help:         help.New(),
+		showHelp:     o.ShowHelp,
+		keymap:       defaultKeymap(),
+		theme:        o.Theme,
+		previewStyle: o.PreviewStyle.ToLipgloss(),
+		editor: editor.Options{
+			Name:    editor.TempName(o.EditorExtension),
+			Command: o.Editor,
+		},
+		editorSubmit: o.EditorSubmit,
 	}
+	if o.GitCommit {
+		m.gitCommit = true
+		m.rulerStyle = o.RulerStyle.ToLipgloss()
+		m.longSubjectStyle = o.LongSubjectStyle.ToLipgloss()
+		m.editor.Name = commitFile
+	}
+	if o.Preview != "none" {
+		m.preview = o.Preview
+		m.layout = layoutSplit
+		m.keymap.TogglePreview.SetEnabled(true)
+	}
+	m.setValue(o.Value)
+	m.resize()
+	m.refreshPreview()
 
-	m.textarea.KeyMap.InsertNewline = m.keymap.InsertNewline
+	if err := bindings.Remap("write", o.Keymap, &m.keymap); err != nil {
+		return err
+	}
+	m.textarea.KeyMap = m.keymap.KeyMap
+	if accessible.On() {
+		value, err := m.ask(o.Value)
+		if err != nil {
+			return err
+		}
+		o.print(value)
+		return nil
+	}
 
 	ctx, cancel := timeout.Context(o.Timeout)
 	defer cancel()
@@ -79,6 +123,17 @@ func (o Options) Run() error {
 	if !m.submitted {
 		return errors.New("not submitted")
 	}
-	fmt.Println(m.textarea.Value())
+	o.print(m.textarea.Value())
 	return nil
 }
+
+// print prints the submitted text.
+func (o Options) print(value string) {
+	if o.GitCommit {
+		value = cleanCommitMessage(value)
+	}
+	if !o.ExpandTabs {
+		value = unexpandTabs(value, o.TabWidth)
+	}
+	fmt.Println(value)
+}
@@ -0,0 +1,108 @@
+package write
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/rivo/uniseg"
+)
+
+// The recommended lengths of the subject and the lines of the body of git
+// commit messages.
+const (
+	subjectLimit = 50
+	bodyLimit    = 72
+)
+
+// commitFile is the name git gives to commit messages, which editors use to
+// detect their filetype.
+const commitFile = "COMMIT_EDITMSG"
+
+// cleanCommitMessage strips comment lines, trailing whitespace and extra
+// blank lines like git does, and separates the subject from the body with a
+// blank line.
+func cleanCommitMessage(s string) string {
+	var lines []string
+	for _, line := range strings.Split(s, "\n") {
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimRight(line, " \t")
+		if line == "" && (len(lines) == 0 || lines[len(lines)-1] == "") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) > 1 && lines[1] != "" {
+		lines = append(lines[:1], append([]string{""}, lines[1:]...)...)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// rulerView renders marks above the columns the subject and the body lines
+// should not exceed.
+func (m model) rulerView() string {
+	var b strings.Builder
+	for col := 1; col <= bodyLimit; col++ {
+		if col == subjectLimit || col == bodyLimit {
+			b.WriteString("┬")
+		} else {
+			b.WriteString("─")
+		}
+	}
+	ruler := ansi.Cut(b.String(), m.hscroll, m.hscroll+m.visibleWidth())
+	left := m.textarea.FocusedStyle.Base.GetBorderLeftSize() + m.textarea.FocusedStyle.Base.GetPaddingLeft()
+	return strings.Repeat(" ", left+m.textStart()) + m.rulerStyle.Render(ruler)
+}
+
+// highlightSubject styles the columns of the subject past the limit in the
+// rendered text area, whose first line of text is at the given row.
+func (m model) highlightSubject(view string, row int) string {
+	subject, _, _ := strings.Cut(m.textarea.Value(), "\n")
+	width := uniseg.StringWidth(subject)
+	if width <= subjectLimit {
+		return view
+	}
+	lines := strings.Split(view, "\n")
+	if row >= len(lines) {
+		return view
+	}
+	left := m.textStart()
+	if m.wrap {
+		base := m.textarea.FocusedStyle.Base
+		left += base.GetBorderLeftSize() + base.GetPaddingLeft()
+	}
+	cursor := -1
+	if info := m.textarea.LineInfo(); m.textarea.Line() == 0 && info.RowOffset == 0 {
+		cursor = left + info.CharOffset
+	}
+	to := left + min(width, m.textarea.Width())
+	lines[row] = highlight(lines[row], left+subjectLimit, to, cursor, m.longSubjectStyle)
+	return strings.Join(lines, "\n")
+}
+
+// highlight styles the columns [from, to) of the line, except the one of
+// the cursor.
+func highlight(line string, from, to, cursor int, style lipgloss.Style) string {
+	var b strings.Builder
+	b.WriteString(ansi.Cut(line, 0, from))
+	for col := from; col < to; {
+		if col == cursor {
+			b.WriteString(ansi.Cut(line, col, col+1))
+			col++
+			continue
+		}
+		end := to
+		if cursor > col && cursor < to {
+			end = cursor
+		}
+		b.WriteString(style.Render(ansi.Strip(ansi.Cut(line, col, end))))
+		col = end
+	}
+	b.WriteString(ansi.Cut(line, to, ansi.StringWidth(line)))
+	return b.String()
+}
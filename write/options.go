@@ -8,20 +8,30 @@ import (
 
 // Options are the customization options for the textarea.
 type Options struct {
-	Width           int           `help:"Text area width (0 for terminal width)" default:"0" env:"GUM_WRITE_WIDTH"`
-	Height          int           `help:"Text area height" default:"5" env:"GUM_WRITE_HEIGHT"`
-	Header          string        `help:"Header value" default:"" env:"GUM_WRITE_HEADER"`
-	Placeholder     string        `help:"Placeholder value" default:"Write something..." env:"GUM_WRITE_PLACEHOLDER"`
-	Prompt          string        `help:"Prompt to display" default:"┃ " env:"GUM_WRITE_PROMPT"`
-	ShowCursorLine  bool          `help:"Show cursor line" default:"false" env:"GUM_WRITE_SHOW_CURSOR_LINE"`
-	ShowLineNumbers bool          `help:"Show line numbers" default:"false" env:"GUM_WRITE_SHOW_LINE_NUMBERS"`
-	Value           string        `help:"Initial value (can be passed via stdin)" default:"" env:"GUM_WRITE_VALUE"`
-	CharLimit       int           `help:"Maximum value length (0 for no limit)" default:"0"`
-	MaxLines        int           `help:"Maximum number of lines (0 for no limit)" default:"0"`
-	ShowHelp        bool          `help:"Show help key binds" negatable:"" default:"true" env:"GUM_WRITE_SHOW_HELP"`
-	CursorMode      string        `prefix:"cursor." name:"mode" help:"Cursor mode" default:"blink" enum:"blink,hide,static" env:"GUM_WRITE_CURSOR_MODE"`
-	Timeout         time.Duration `help:"Timeout until choose returns selected element" default:"0s" env:"GUM_WRITE_TIMEOUT"`
-	StripANSI       bool          `help:"Strip ANSI sequences when reading from STDIN" default:"true" negatable:"" env:"GUM_WRITE_STRIP_ANSI"`
+	Width           int               `help:"Text area width (0 for terminal width)" default:"0" env:"GUM_WRITE_WIDTH"`
+	Height          int               `help:"Text area height" default:"5" env:"GUM_WRITE_HEIGHT"`
+	Header          string            `help:"Header value" default:"" env:"GUM_WRITE_HEADER"`
+	Placeholder     string            `help:"Placeholder value" default:"Write something..." env:"GUM_WRITE_PLACEHOLDER"`
+	Prompt          string            `help:"Prompt to display" default:"┃ " env:"GUM_WRITE_PROMPT"`
+	ShowCursorLine  bool              `help:"Show cursor line" default:"false" env:"GUM_WRITE_SHOW_CURSOR_LINE"`
+	ShowLineNumbers bool              `help:"Show line numbers" default:"false" env:"GUM_WRITE_SHOW_LINE_NUMBERS"`
+	Value           string            `help:"Initial value (can be passed via stdin)" default:"" env:"GUM_WRITE_VALUE"`
+	CharLimit       int               `help:"Maximum value length (0 for no limit)" default:"0"`
+	MaxLines        int               `help:"Maximum number of lines (0 for no limit)" default:"0"`
+	ShowHelp        bool              `help:"Show help key binds" negatable:"" default:"true" env:"GUM_WRITE_SHOW_HELP"`
+	Keymap          map[string]string `help:"Remap key bindings, as action=keys (e.g. down=ctrl+j,down)" placeholder:"action=keys;..." env:"GUM_WRITE_KEYMAP"`
+	CursorMode      string            `prefix:"cursor." name:"mode" help:"Cursor mode" default:"blink" enum:"blink,hide,static" env:"GUM_WRITE_CURSOR_MODE"`
+	Timeout         time.Duration     `help:"Timeout until choose returns selected element" default:"0s" env:"GUM_WRITE_TIMEOUT"`
+	StripANSI       bool              `help:"Strip ANSI sequences when reading from STDIN" default:"true" negatable:"" env:"GUM_WRITE_STRIP_ANSI"`
+	TabWidth        int               `help:"Number of columns of a tab" default:"4" env:"GUM_WRITE_TAB_WIDTH"`
+	ExpandTabs      bool              `help:"Write tabs as spaces; otherwise every tab width of leading spaces is written as a tab, typed or not" default:"true" negatable:"" env:"GUM_WRITE_EXPAND_TABS"`
+	Editor          string            `help:"Editor command opened with ctrl+e (defaults to $EDITOR)" default:"" env:"GUM_WRITE_EDITOR" group:"Editor"`
+	EditorExtension string            `help:"Extension of the file opened in the editor, for its syntax" default:"md" env:"GUM_WRITE_EDITOR_EXTENSION" group:"Editor"`
+	EditorSubmit    bool              `help:"Submit the text when the editor exits" default:"false" env:"GUM_WRITE_EDITOR_SUBMIT" group:"Editor"`
+	GitCommit       bool              `help:"Write a git commit message: show a ruler at 50 and 72 columns, highlight long subjects and strip # comment lines" env:"GUM_WRITE_GIT_COMMIT"`
+	Preview         string            `help:"Preview the text as it is written, side by side (switch between editor, split and preview with ctrl+o)" enum:"none,markdown" default:"none" env:"GUM_WRITE_PREVIEW"`
+	Theme           string            `help:"Glamour theme to use for the markdown preview" default:"pink" env:"GUM_WRITE_THEME"`
+	Wrap            bool              `help:"Soft-wrap long lines; otherwise scroll horizontally" default:"true" negatable:"" env:"GUM_WRITE_WRAP"`
 
 	BaseStyle             style.Styles `embed:"" prefix:"base." envprefix:"GUM_WRITE_BASE_"`
 	CursorLineNumberStyle style.Styles `embed:"" prefix:"cursor-line-number." set:"defaultForeground=7" envprefix:"GUM_WRITE_CURSOR_LINE_NUMBER_"`
@@ -32,4 +42,8 @@ type Options struct {
 	HeaderStyle           style.Styles `embed:"" prefix:"header." set:"defaultForeground=240" envprefix:"GUM_WRITE_HEADER_"`
 	PlaceholderStyle      style.Styles `embed:"" prefix:"placeholder." set:"defaultForeground=240" envprefix:"GUM_WRITE_PLACEHOLDER_"`
 	PromptStyle           style.Styles `embed:"" prefix:"prompt." set:"defaultForeground=7" envprefix:"GUM_WRITE_PROMPT_"`
+	WarningStyle          style.Styles `embed:"" prefix:"warning." set:"defaultForeground=214" envprefix:"GUM_WRITE_WARNING_"`
+	RulerStyle            style.Styles `embed:"" prefix:"ruler." set:"defaultForeground=240" envprefix:"GUM_WRITE_RULER_"`
+	LongSubjectStyle      style.Styles `embed:"" prefix:"long-subject." set:"defaultForeground=9" envprefix:"GUM_WRITE_LONG_SUBJECT_"`
+	PreviewStyle          style.Styles `embed:"" prefix:"preview." set:"defaultBorder=rounded" set:"defaultBorderForeground=240" set:"defaultPadding=0 1" envprefix:"GUM_WRITE_PREVIEW_"` //nolint:staticcheck
 }
@@ -0,0 +1,125 @@
+package write
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/gum/format"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// layout is what is shown when previewing: the text area, the preview, or
+// both side by side.
+type layout int
+
+const (
+	layoutEditor layout = iota
+	layoutSplit
+	layoutPreview
+)
+
+// next returns the layout the preview key switches to.
+func (l layout) next() layout {
+	switch l {
+	case layoutSplit:
+		return layoutPreview
+	case layoutPreview:
+		return layoutEditor
+	default:
+		return layoutSplit
+	}
+}
+
+// editorWidth returns the width of the text area.
+func (m model) editorWidth() int {
+	if m.layout == layoutSplit {
+		return m.width / 2
+	}
+	return m.width
+}
+
+// previewWidth returns the width of the preview pane, borders included.
+func (m model) previewWidth() int {
+	if m.layout == layoutSplit {
+		return m.width - m.editorWidth()
+	}
+	return m.width
+}
+
+// refreshPreview renders the text again if it or the width changed.
+func (m *model) refreshPreview() {
+	if m.preview == "" || m.layout == layoutEditor {
+		return
+	}
+	value := m.textarea.Value()
+	width := max(1, m.previewWidth()-m.previewStyle.GetHorizontalFrameSize())
+	if value == m.renderedValue && width == m.renderedWidth && m.rendered != nil {
+		return
+	}
+	m.renderedValue, m.renderedWidth = value, width
+
+	out, err := format.Markdown(value, m.theme, width)
+	if err != nil {
+		m.rendered = []string{err.Error()}
+		return
+	}
+	lines := strings.Split(out, "\n")
+	blank := func(line string) bool { return strings.TrimSpace(ansi.Strip(line)) == "" }
+	for len(lines) > 0 && blank(lines[0]) {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && blank(lines[len(lines)-1]) {
+		lines = lines[:len(lines)-1]
+	}
+	m.rendered = lines
+}
+
+// previewView renders the preview pane with the given height. It scrolls
+// along with the cursor through the text.
+func (m model) previewView(height int) string {
+	inner := max(0, height-m.previewStyle.GetVerticalFrameSize())
+	lines := m.rendered
+	if len(lines) > inner {
+		offset := 0
+		if n := m.textarea.LineCount(); n > 1 {
+			offset = m.textarea.Line() * (len(lines) - inner) / (n - 1)
+		}
+		lines = lines[offset : offset+inner]
+	}
+	return m.previewStyle.
+		Width(m.previewWidth() - m.previewStyle.GetHorizontalBorderSize()).
+		Height(height - m.previewStyle.GetVerticalBorderSize()).
+		MaxHeight(height).
+		Render(strings.Join(lines, "\n"))
+}
+
+// editorView renders the text area.
+func (m model) editorView() string {
+	var view string
+	switch {
+	case !m.wrap:
+		view = m.scrolledView()
+	case m.gitCommit:
+		base := m.textarea.FocusedStyle.Base
+		view = m.highlightSubject(m.textarea.View(), base.GetBorderTopSize()+base.GetPaddingTop())
+	default:
+		view = m.textarea.View()
+	}
+	if m.gitCommit {
+		return lipgloss.JoinVertical(lipgloss.Left, m.rulerView(), view)
+	}
+	return view
+}
+
+// bodyView renders the text area and the preview according to the layout.
+func (m model) bodyView() string {
+	editor := m.editorView()
+	switch {
+	case m.preview == "" || m.layout == layoutEditor:
+		return editor
+	case m.layout == layoutPreview:
+		return m.previewView(lipgloss.Height(editor))
+	default:
+		return lipgloss.JoinHorizontal(lipgloss.Top, editor, m.previewView(lipgloss.Height(editor)))
+	}
+}
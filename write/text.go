@@ -0,0 +1,68 @@
+package write
+
+import (
+	"strings"
+)
+
+// normalizeNewlines converts Windows and old Mac line endings to newlines.
+func normalizeNewlines(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "\r\n", "\n"), "\r", "\n")
+}
+
+// expandTabs replaces tabs with spaces up to the next tab stop.
+func expandTabs(s string, width int) string {
+	if !strings.Contains(s, "\t") {
+		return s
+	}
+	var b strings.Builder
+	col := 0
+	for _, r := range s {
+		switch r {
+		case '\t':
+			n := width - col%width
+			b.WriteString(strings.Repeat(" ", n))
+			col += n
+		case '\n':
+			b.WriteRune(r)
+			col = 0
+		default:
+			b.WriteRune(r)
+			col++
+		}
+	}
+	return b.String()
+}
+
+// unexpandTabs replaces the spaces indenting each line with tabs, a tab for
+// each width of spaces whether or not they came from tabs.
+func unexpandTabs(s string, width int) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+		tabs := indent / width
+		lines[i] = strings.Repeat("\t", tabs) + line[tabs*width:]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// fit truncates the text to at most chars characters (newlines included) and
+// lines extra lines. Negative values mean no limit.
+func fit(text string, chars, lines int) string {
+	runes := []rune(text)
+	if chars >= 0 && len(runes) > chars {
+		runes = runes[:chars]
+	}
+	if lines >= 0 {
+		for i, r := range runes {
+			if r != '\n' {
+				continue
+			}
+			if lines == 0 {
+				runes = runes[:i]
+				break
+			}
+			lines--
+		}
+	}
+	return string(runes)
+}
@@ -0,0 +1,118 @@
+package write
+
+import (
+	"testing"
+)
+
+func TestNormalizeNewlines(t *testing.T) {
+	for in, out := range map[string]string{
+		"a\nb":       "a\nb",
+		"a\r\nb\r\n": "a\nb\n",
+		"a\rb":       "a\nb",
+		"a\r\r\nb":   "a\n\nb",
+	} {
+		if got := normalizeNewlines(in); got != out {
+			t.Errorf("expected %q for %q, got %q", out, in, got)
+		}
+	}
+}
+
+func TestExpandTabs(t *testing.T) {
+	for name, tt := range map[string]struct {
+		in    string
+		width int
+		out   string
+	}{
+		"no tabs":          {in: "a  b", width: 4, out: "a  b"},
+		"leading":          {in: "\ta", width: 4, out: "    a"},
+		"two leading":      {in: "\t\ta", width: 2, out: "    a"},
+		"mid-line":         {in: "ab\tc", width: 4, out: "ab  c"},
+		"at a stop":        {in: "abcd\te", width: 4, out: "abcd    e"},
+		"after spaces":     {in: "  \ta", width: 4, out: "    a"},
+		"on each line":     {in: "abc\td\n\te", width: 4, out: "abc d\n    e"},
+		"accented letters": {in: "é\tx", width: 4, out: "é   x"},
+		"width of one":     {in: "a\tb", width: 1, out: "a b"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if got := expandTabs(tt.in, tt.width); got != tt.out {
+				t.Errorf("expected %q, got %q", tt.out, got)
+			}
+		})
+	}
+}
+
+func TestUnexpandTabs(t *testing.T) {
+	for name, tt := range map[string]struct {
+		in    string
+		width int
+		out   string
+	}{
+		"no indentation":    {in: "a  b", width: 4, out: "a  b"},
+		"one level":         {in: "    a", width: 4, out: "\ta"},
+		"two levels":        {in: "    a\n        b", width: 4, out: "\ta\n\t\tb"},
+		"partial level":     {in: "      a", width: 4, out: "\t  a"},
+		"less than a level": {in: "  a", width: 4, out: "  a"},
+		"inner spaces kept": {in: "    a    b", width: 4, out: "\ta    b"},
+		"blank line":        {in: "a\n    \nb", width: 4, out: "a\n\t\nb"},
+		"round trip":        {in: expandTabs("\tif x {\n\t\ty()\n\t}", 2), width: 2, out: "\tif x {\n\t\ty()\n\t}"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if got := unexpandTabs(tt.in, tt.width); got != tt.out {
+				t.Errorf("expected %q, got %q", tt.out, got)
+			}
+		})
+	}
+}
+
+func TestFit(t *testing.T) {
+	for name, tt := range map[string]struct {
+		in    string
+		chars int
+		lines int
+		out   string
+	}{
+		"no limits":            {in: "a\nb\nc", chars: -1, lines: -1, out: "a\nb\nc"},
+		"within the limits":    {in: "a\nb", chars: 3, lines: 1, out: "a\nb"},
+		"characters":           {in: "abcdef", chars: 4, lines: -1, out: "abcd"},
+		"newlines are counted": {in: "ab\ncd", chars: 4, lines: -1, out: "ab\nc"},
+		"runes":                {in: "héllo", chars: 2, lines: -1, out: "hé"},
+		"no characters left":   {in: "abc", chars: 0, lines: -1, out: ""},
+		"extra lines":          {in: "a\nb\nc\nd", chars: -1, lines: 2, out: "a\nb\nc"},
+		"no extra line":        {in: "a\nb", chars: -1, lines: 0, out: "a"},
+		"both":                 {in: "abc\ndef\nghi", chars: 6, lines: 2, out: "abc\nde"},
+		"lines before chars":   {in: "a\nb\nc\nd", chars: 100, lines: 1, out: "a\nb"},
+		"trailing newline":     {in: "a\n", chars: -1, lines: 0, out: "a"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if got := fit(tt.in, tt.chars, tt.lines); got != tt.out {
+				t.Errorf("expected %q, got %q", tt.out, got)
+			}
+		})
+	}
+}
+
+func TestCleanCommitMessage(t *testing.T) {
+	for name, tt := range map[string]struct {
+		in  string
+		out string
+	}{
+		"subject only":          {in: "Fix the parser", out: "Fix the parser"},
+		"subject and body":      {in: "Fix\n\nBody", out: "Fix\n\nBody"},
+		"body right after":      {in: "Fix\nBody", out: "Fix\n\nBody"},
+		"comments":              {in: "Fix\n# Please enter\n\nBody\n# On branch main", out: "Fix\n\nBody"},
+		"indented hash kept":    {in: "Fix\n\n  # not a comment", out: "Fix\n\n  # not a comment"},
+		"trailing whitespace":   {in: "Fix  \n\nBody\t", out: "Fix\n\nBody"},
+		"leading blank lines":   {in: "\n\n  \nFix", out: "Fix"},
+		"trailing blank lines":  {in: "Fix\n\nBody\n\n\n", out: "Fix\n\nBody"},
+		"blank lines collapsed": {in: "Fix\n\n\n\nA\n\n\nB", out: "Fix\n\nA\n\nB"},
+		"blank around comments": {in: "Fix\n\n# c\n\nBody", out: "Fix\n\nBody"},
+		"only comments":         {in: "# a\n# b\n", out: ""},
+		"empty":                 {in: "", out: ""},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if got := cleanCommitMessage(tt.in); got != tt.out {
+				t.Errorf("expected %q, got %q", tt.out, got)
+			}
+		})
+	}
+}
@@ -9,23 +9,29 @@
 package write
 
 import (
-	"io"
-	"os"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/textarea"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/gum/internal/editor"
 	"github.com/charmbracelet/lipgloss"
-	"github.com/charmbracelet/x/editor"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/rivo/uniseg"
 )
 
 type keymap struct {
 	textarea.KeyMap
-	Submit       key.Binding
-	Quit         key.Binding
-	Abort        key.Binding
-	OpenInEditor key.Binding
+	Submit        key.Binding
+	Quit          key.Binding
+	Abort         key.Binding
+	OpenInEditor  key.Binding
+	InsertTab     key.Binding
+	TogglePreview key.Binding
 }
 
 // FullHelp implements help.KeyMap.
@@ -36,6 +42,7 @@ func (k keymap) ShortHelp() []key.Binding {
 	return []key.Binding{
 		k.InsertNewline,
 		k.OpenInEditor,
+		k.TogglePreview,
 		k.Submit,
 	}
 }
@@ -64,19 +71,45 @@ func defaultKeymap() keymap {
 			key.WithKeys("enter"),
 			key.WithHelp("enter", "submit"),
 		),
+		InsertTab: key.NewBinding(
+			key.WithKeys("tab"),
+		),
+		TogglePreview: key.NewBinding(
+			key.WithKeys("ctrl+o"),
+			key.WithHelp("ctrl+o", "preview"),
+			key.WithDisabled(),
+		),
 	}
 }
 
 type model struct {
-	autoWidth   bool
-	header      string
-	headerStyle lipgloss.Style
-	quitting    bool
-	submitted   bool
-	textarea    textarea.Model
-	showHelp    bool
-	help        help.Model
-	keymap      keymap
+	autoWidth        bool
+	width            int
+	wrap             bool
+	hscroll          int
+	tabWidth         int
+	header           string
+	headerStyle      lipgloss.Style
+	warning          string
+	warningStyle     lipgloss.Style
+	gitCommit        bool
+	rulerStyle       lipgloss.Style
+	longSubjectStyle lipgloss.Style
+	editor           editor.Options
+	editorSubmit     bool
+	preview          string
+	layout           layout
+	theme            string
+	previewStyle     lipgloss.Style
+	rendered         []string
+	renderedValue    string
+	renderedWidth    int
+	quitting         bool
+	submitted        bool
+	textarea         textarea.Model
+	showHelp         bool
+	help             help.Model
+	keymap           keymap
 }
 
 func (m model) Init() tea.Cmd { return textarea.Blink }
@@ -92,32 +125,155 @@ func (m model) View() string {
 	if m.header != "" {
 		parts = append(parts, m.headerStyle.Render(m.header))
 	}
-	parts = append(parts, m.textarea.View())
+	parts = append(parts, m.bodyView())
+	if m.warning != "" {
+		parts = append(parts, m.warningStyle.Render(m.warning))
+	}
 	if m.showHelp {
 		parts = append(parts, m.help.View(m.keymap))
 	}
 	return lipgloss.JoinVertical(lipgloss.Left, parts...)
 }
 
+// scrolledView renders the visible columns of the unwrapped text area, with
+// the prompt and line numbers.
+func (m model) scrolledView() string {
+	ta := m.textarea
+	base := ta.FocusedStyle.Base
+	ta.FocusedStyle.Base = lipgloss.NewStyle()
+	ta.BlurredStyle.Base = lipgloss.NewStyle()
+	// Point the active style to the copy.
+	if ta.Focused() {
+		ta.Focus()
+	} else {
+		ta.Blur()
+	}
+
+	view := ta.View()
+	if m.gitCommit {
+		view = m.highlightSubject(view, 0)
+	}
+	gutter := m.textStart()
+	lines := strings.Split(view, "\n")
+	for i, line := range lines {
+		lines[i] = ansi.Cut(line, 0, gutter) + ansi.Cut(line, gutter+m.hscroll, gutter+m.hscroll+m.visibleWidth())
+	}
+	return base.Render(strings.Join(lines, "\n"))
+}
+
+// lineNumberWidth is the width the text area reserves for line numbers.
+const lineNumberWidth = 4
+
+// gutter returns the width of the prompt and line numbers.
+func (m model) gutter() int {
+	w := uniseg.StringWidth(m.textarea.Prompt)
+	if m.textarea.ShowLineNumbers {
+		w += lineNumberWidth
+	}
+	return w
+}
+
+// textStart returns the column of the text in the rendered lines, which may
+// be before the end of the gutter as the line numbers are padded to the
+// digits of the maximum height.
+func (m model) textStart() int {
+	start := m.gutter()
+	if m.textarea.ShowLineNumbers {
+		start += len(strconv.Itoa(m.textarea.MaxHeight)) + 2 - lineNumberWidth
+	}
+	return start
+}
+
+// visibleWidth returns the number of columns of text that fit the width.
+func (m model) visibleWidth() int {
+	return max(1, m.editorWidth()-m.textarea.FocusedStyle.Base.GetHorizontalFrameSize()-m.gutter())
+}
+
+// resize sets the width of the text area. Without wrapping, it is made as
+// wide as the longest line, and scrolled horizontally to the cursor.
+func (m *model) resize() {
+	if m.wrap {
+		m.textarea.SetWidth(m.editorWidth())
+		return
+	}
+	longest := 0
+	for _, line := range strings.Split(m.textarea.Value(), "\n") {
+		longest = max(longest, uniseg.StringWidth(line))
+	}
+	frame := m.textarea.FocusedStyle.Base.GetHorizontalFrameSize()
+	m.textarea.SetWidth(max(m.editorWidth(), frame+m.gutter()+longest+1))
+
+	x := m.textarea.LineInfo().CharOffset
+	if x < m.hscroll {
+		m.hscroll = x
+	} else if w := m.visibleWidth(); x >= m.hscroll+w {
+		m.hscroll = x - w + 1
+	}
+}
+
+// paste prepares pasted text for the text area, truncating it to the
+// character and line limits.
+func (m *model) paste(text string) []rune {
+	text = expandTabs(normalizeNewlines(text), m.tabWidth)
+	chars, lines := -1, -1
+	if m.textarea.CharLimit > 0 {
+		chars = max(0, m.textarea.CharLimit-m.textarea.Length())
+	}
+	if m.textarea.MaxHeight > 0 {
+		lines = max(0, m.textarea.MaxHeight-m.textarea.LineCount())
+	}
+	fitted := fit(text, chars, lines)
+	if fitted != text {
+		m.warning = fmt.Sprintf(
+			"Pasted text truncated to fit the limits (%d of %d characters inserted)",
+			utf8.RuneCountInString(fitted), utf8.RuneCountInString(text),
+		)
+	}
+	return []rune(fitted)
+}
+
+// setValue sets the text, expanding its tabs to the tab width rather than to
+// the text area's fixed width.
+func (m *model) setValue(s string) {
+	m.textarea.SetValue(expandTabs(normalizeNewlines(s), m.tabWidth))
+}
+
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	m, cmd := m.update(msg)
+	if !m.wrap {
+		m.resize()
+	}
+	m.refreshPreview()
+	return m, cmd
+}
+
+func (m model) update(msg tea.Msg) (model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
 		if m.autoWidth {
-			m.textarea.SetWidth(msg.Width)
+			m.width = msg.Width
+			m.resize()
 		}
 	case tea.FocusMsg, tea.BlurMsg:
 		var cmd tea.Cmd
 		m.textarea, cmd = m.textarea.Update(msg)
 		return m, cmd
-	case startEditorMsg:
-		return m, openEditor(msg.path, msg.lineno)
-	case editorFinishedMsg:
-		if msg.err != nil {
+	case editor.FinishedMsg:
+		if msg.Err != nil {
 			m.quitting = true
 			return m, tea.Interrupt
 		}
-		m.textarea.SetValue(msg.content)
+		m.setValue(msg.Content)
+		if m.editorSubmit {
+			m.quitting = true
+			m.submitted = true
+			return m, tea.Quit
+		}
 	case tea.KeyMsg:
+		m.warning = ""
+		if msg.Paste {
+			msg.Runes = m.paste(string(msg.Runes))
+		}
 		km := m.keymap
 		switch {
 		case key.Matches(msg, km.Abort):
@@ -132,66 +288,29 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, tea.Quit
 		case key.Matches(msg, km.OpenInEditor):
 			//nolint: gosec
-			return m, createTempFile(m.textarea.Value(), uint(m.textarea.Line())+1)
+			m.editor.Line = uint(m.textarea.Line()) + 1
+			return m, editor.Open(m.textarea.Value(), m.editor)
+		case key.Matches(msg, km.TogglePreview):
+			m.layout = m.layout.next()
+			m.resize()
+			return m, nil
+		case m.gitCommit && key.Matches(msg, km.InsertNewline) &&
+			m.textarea.LineCount() == 1 && m.textarea.Value() != "":
+			// Keep a blank line after the subject.
+			m.textarea.InsertString("\n")
+		case key.Matches(msg, km.InsertTab):
+			// Indent to the next tab stop.
+			info := m.textarea.LineInfo()
+			col := info.StartColumn + info.ColumnOffset
+			spaces := strings.Repeat(" ", m.tabWidth-col%m.tabWidth)
+			msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(spaces)}
 		}
+		var cmd tea.Cmd
+		m.textarea, cmd = m.textarea.Update(msg)
+		return m, cmd
 	}
 
 	var cmd tea.Cmd
 	m.textarea, cmd = m.textarea.Update(msg)
 	return m, cmd
 }
-
-type startEditorMsg struct {
-	path   string
-	lineno uint
-}
-
-type editorFinishedMsg struct {
-	content string
-	err     error
-}
-
-func createTempFile(content string, lineno uint) tea.Cmd {
-	return func() tea.Msg {
-		f, err := os.CreateTemp("", "gum.*.md")
-		if err != nil {
-			return editorFinishedMsg{err: err}
-		}
-		_, err = io.WriteString(f, content)
-		if err != nil {
-			return editorFinishedMsg{err: err}
-		}
-		_ = f.Close()
-		return startEditorMsg{
-			path:   f.Name(),
-			lineno: lineno,
-		}
-	}
-}
-
-func openEditor(path string, lineno uint) tea.Cmd {
-	cb := func(err error) tea.Msg {
-		if err != nil {
-			return editorFinishedMsg{
-				err: err,
-			}
-		}
-		bts, err := os.ReadFile(path)
-		if err != nil {
-			return editorFinishedMsg{err: err}
-		}
-		return editorFinishedMsg{
-			content: string(bts),
-		}
-	}
-	cmd, err := editor.Cmd(
-		"Gum",
-		path,
-		editor.LineNumber(lineno),
-		editor.EndOfLine(),
-	)
-	if err != nil {
-		return func() tea.Msg { return cb(err) }
-	}
-	return tea.ExecProcess(cmd, cb)
-}